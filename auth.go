@@ -0,0 +1,165 @@
+package gotwtr
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// Authenticator sets the credentials of the requests of the client. It is called before every attempt,
+// so that retries carry a fresh token or nonce, and it must be safe for concurrent use.
+type Authenticator interface {
+	Authenticate(req *http.Request) error
+}
+
+// BearerTokenAuthenticator sends a bearer token, which can be swapped while requests are in flight.
+type BearerTokenAuthenticator struct {
+	mu    sync.RWMutex
+	token string
+}
+
+var _ Authenticator = (*BearerTokenAuthenticator)(nil)
+
+func NewBearerTokenAuthenticator(token string) *BearerTokenAuthenticator {
+	return &BearerTokenAuthenticator{token: token}
+}
+
+func (a *BearerTokenAuthenticator) Authenticate(req *http.Request) error {
+	req.Header.Set("Authorization", "Bearer "+a.Token())
+	return nil
+}
+
+// Token returns the current bearer token.
+func (a *BearerTokenAuthenticator) Token() string {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.token
+}
+
+// SetToken replaces the bearer token for the following requests.
+func (a *BearerTokenAuthenticator) SetToken(token string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.token = token
+}
+
+// appOnlyAuthenticator acquires an app-only bearer token with the consumer key and secret of the client on first use,
+// and again once the token has been invalidated.
+type appOnlyAuthenticator struct {
+	c *client
+
+	mu    sync.Mutex
+	token string
+}
+
+func (a *appOnlyAuthenticator) Authenticate(req *http.Request) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.token == "" {
+		token, err := requestAppOnlyBearerToken(req.Context(), a.c)
+		if err != nil {
+			return err
+		}
+		a.token = token
+	}
+	req.Header.Set("Authorization", "Bearer "+a.token)
+	return nil
+}
+
+func (a *appOnlyAuthenticator) Token() string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.token
+}
+
+func (a *appOnlyAuthenticator) SetToken(token string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.token = token
+}
+
+type oauth2Authenticator struct {
+	ts TokenSource
+}
+
+// NewOAuth2Authenticator returns an Authenticator which sends the user access token of ts.
+func NewOAuth2Authenticator(ts TokenSource) Authenticator {
+	return &oauth2Authenticator{ts: ts}
+}
+
+func (a *oauth2Authenticator) Authenticate(req *http.Request) error {
+	t, err := a.ts.Token(req.Context())
+	if err != nil {
+		return fmt.Errorf("oauth2 token: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+t.AccessToken)
+	return nil
+}
+
+// tokenSetter is implemented by the authenticators whose bearer token is managed by
+// GenerateAppOnlyBearerToken and InvalidateToken.
+type tokenSetter interface {
+	Token() string
+	SetToken(token string)
+}
+
+// WithAuthenticator makes the client authenticate its requests with a.
+// It takes precedence over the bearer token given to New, WithAccessToken and WithOAuth2Token.
+func WithAuthenticator(a Authenticator) ClientOption {
+	return func(c *client) {
+		c.auth = a
+	}
+}
+
+// WithAppOnlyAuth makes the client acquire an app-only bearer token with the consumer key and secret
+// before its first request, instead of using the bearer token given to New.
+func WithAppOnlyAuth() ClientOption {
+	return func(c *client) {
+		c.auth = &appOnlyAuthenticator{c: c}
+	}
+}
+
+// authenticator returns the authenticator of c.
+func (c *client) authenticator() Authenticator {
+	c.authMu.RLock()
+	defer c.authMu.RUnlock()
+	return c.auth
+}
+
+// setAuthenticator replaces the authenticator of c. Requests in flight keep the credentials they were sent with.
+func (c *client) setAuthenticator(a Authenticator) {
+	c.authMu.Lock()
+	defer c.authMu.Unlock()
+	c.auth = a
+}
+
+// setBearerToken swaps the bearer token of c, switching it to bearer token authentication if it used another strategy.
+func (c *client) setBearerToken(token string) {
+	c.authMu.Lock()
+	defer c.authMu.Unlock()
+	if s, ok := c.auth.(tokenSetter); ok {
+		s.SetToken(token)
+		return
+	}
+	c.auth = NewBearerTokenAuthenticator(token)
+}
+
+// currentBearerToken returns the bearer token of c, or an empty string if it does not use one.
+func (c *client) currentBearerToken() string {
+	if s, ok := c.authenticator().(tokenSetter); ok {
+		return s.Token()
+	}
+	return ""
+}
+
+// authorize sets the credentials of req with the authenticator of c.
+// Endpoints mark the requests which need them with a bearer Authorization header;
+// requests authenticated otherwise, such as those of the OAuth 2.0 endpoints, are left untouched.
+func (c *client) authorize(req *http.Request) error {
+	auth := req.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "Bearer ") && !strings.HasPrefix(auth, "OAuth ") {
+		return nil
+	}
+	return c.authenticator().Authenticate(req)
+}
@@ -0,0 +1,91 @@
+package gotwtr_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/sivchari/gotwtr"
+)
+
+func Test_AppOnlyAuthInvalidateToken(t *testing.T) {
+	t.Parallel()
+	var mu sync.Mutex
+	var issued, invalidated []string
+	c := gotwtr.New("",
+		gotwtr.WithConsumerKey("consumerKey"),
+		gotwtr.WithConsumerSecret("consumerSecret"),
+		gotwtr.WithAppOnlyAuth(),
+		gotwtr.WithHTTPClient(mockHTTPClient(func(req *http.Request) *http.Response {
+			mu.Lock()
+			defer mu.Unlock()
+			var data string
+			switch req.URL.Path {
+			case "/oauth2/token":
+				if id, secret, ok := req.BasicAuth(); !ok || id != "consumerKey" || secret != "consumerSecret" {
+					t.Errorf("token requests must authenticate with the consumer key and secret")
+				}
+				issued = append(issued, fmt.Sprintf("token%d", len(issued)+1))
+				data = `{"token_type": "bearer", "access_token": "` + issued[len(issued)-1] + `"}`
+			case "/oauth2/invalidate_token":
+				if err := req.ParseForm(); err != nil {
+					t.Fatalf("ParseForm() error = %v", err)
+				}
+				invalidated = append(invalidated, req.PostForm.Get("access_token"))
+				data = `{"access_token": "` + req.PostForm.Get("access_token") + `"}`
+			default:
+				if got, want := req.Header.Get("Authorization"), "Bearer "+issued[len(issued)-1]; got != want {
+					t.Errorf("Authorization = %q, want %q", got, want)
+				}
+				data = `{"data": {"id": "2244994945", "name": "Twitter Dev", "username": "TwitterDev"}}`
+			}
+			return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(data))}
+		})),
+	)
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		if _, err := c.RetrieveSingleUserWithID(ctx, "2244994945"); err != nil {
+			t.Fatalf("RetrieveSingleUserWithID() error = %v", err)
+		}
+	}
+	if ok, err := c.InvalidateToken(ctx); !ok || err != nil {
+		t.Fatalf("InvalidateToken() = %v, %v", ok, err)
+	}
+	if _, err := c.RetrieveSingleUserWithID(ctx, "2244994945"); err != nil {
+		t.Fatalf("RetrieveSingleUserWithID() error = %v", err)
+	}
+	if len(issued) != 2 || len(invalidated) != 1 || invalidated[0] != "token1" {
+		t.Errorf("issued = %v, invalidated = %v, want a new token after the invalidation", issued, invalidated)
+	}
+}
+
+func Test_SetBearerTokenConcurrently(t *testing.T) {
+	t.Parallel()
+	c := gotwtr.New("token0", gotwtr.WithHTTPClient(mockHTTPClient(func(req *http.Request) *http.Response {
+		if !strings.HasPrefix(req.Header.Get("Authorization"), "Bearer token") {
+			t.Errorf("Authorization = %q", req.Header.Get("Authorization"))
+		}
+		data := `{"data": {"id": "2244994945", "name": "Twitter Dev", "username": "TwitterDev"}}`
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(data))}
+	})))
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		i := i
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			c.SetBearerToken(fmt.Sprintf("token%d", i))
+		}()
+		go func() {
+			defer wg.Done()
+			if _, err := c.RetrieveSingleUserWithID(context.Background(), "2244994945"); err != nil {
+				t.Errorf("RetrieveSingleUserWithID() error = %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -13,7 +13,7 @@ func blocking(ctx context.Context, c *client, userID string, opt ...*BlockOption
 	if userID == "" {
 		return nil, errors.New("blocking: userID parameter is required")
 	}
-	ep := fmt.Sprintf(blockingURL, userID)
+	ep := c.baseURL + fmt.Sprintf(blockingURL, userID)
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ep, nil)
 	if err != nil {
 		return nil, fmt.Errorf("blocking new request with ctx: %w", err)
@@ -29,7 +29,7 @@ func blocking(ctx context.Context, c *client, userID string, opt ...*BlockOption
 		return nil, errors.New("blocking: only one option is allowed")
 	}
 	ropt.addQuery(req)
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("blocking response: %w", err)
 	}
@@ -39,11 +39,7 @@ func blocking(ctx context.Context, c *client, userID string, opt ...*BlockOption
 		return nil, fmt.Errorf("blocking decode: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &blocking, &HTTPError{
-			APIName: "blocking",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &blocking, newAPIError("blocking", req, resp)
 	}
 
 	return &blocking, nil
@@ -53,7 +49,7 @@ func postBlocking(ctx context.Context, c *client, userID string, targetUserID st
 	if userID == "" {
 		return nil, errors.New("post blocking: userID parameter is required")
 	}
-	ep := fmt.Sprintf(postBlockingURL, userID)
+	ep := c.baseURL + fmt.Sprintf(postBlockingURL, userID)
 
 	if targetUserID == "" {
 		return nil, errors.New("post blocking: targetUserID parameter is required")
@@ -73,7 +69,7 @@ func postBlocking(ctx context.Context, c *client, userID string, targetUserID st
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.bearerToken))
 	req.Header.Set("Content-Type", "application/json")
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("post blocking response: %w", err)
 	}
@@ -84,11 +80,7 @@ func postBlocking(ctx context.Context, c *client, userID string, targetUserID st
 		return nil, fmt.Errorf("post blocking decode: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &postBlocking, &HTTPError{
-			APIName: "post blocking",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &postBlocking, newAPIError("post blocking", req, resp)
 	}
 
 	return &postBlocking, nil
@@ -101,7 +93,7 @@ func undoBlocking(ctx context.Context, c *client, sourceUserID string, targetUse
 	if targetUserID == "" {
 		return nil, errors.New("undo blocking: targetUserID parameter is required")
 	}
-	ep := fmt.Sprintf(undoBlockingURL, sourceUserID, targetUserID)
+	ep := c.baseURL + fmt.Sprintf(undoBlockingURL, sourceUserID, targetUserID)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, ep, nil)
 	if err != nil {
@@ -109,7 +101,7 @@ func undoBlocking(ctx context.Context, c *client, sourceUserID string, targetUse
 	}
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.bearerToken))
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("undo blocking response: %w", err)
 	}
@@ -120,11 +112,7 @@ func undoBlocking(ctx context.Context, c *client, sourceUserID string, targetUse
 		return nil, fmt.Errorf("undo blocking decode: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &undoBlocking, &HTTPError{
-			APIName: "undo blocking",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &undoBlocking, newAPIError("undo blocking", req, resp)
 	}
 
 	return &undoBlocking, nil
@@ -13,7 +13,7 @@ func lookupUserBookmarks(ctx context.Context, c *client, userID string, opt ...*
 	if userID == "" {
 		return nil, errors.New("lookup user bookmarks: user id parameter is required")
 	}
-	ep := fmt.Sprintf(lookupUserBookmarksURL, userID)
+	ep := c.baseURL + fmt.Sprintf(lookupUserBookmarksURL, userID)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ep, nil)
 	if err != nil {
@@ -32,7 +32,7 @@ func lookupUserBookmarks(ctx context.Context, c *client, userID string, opt ...*
 	}
 	lopt.addQuery(req)
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("lookup user bookmarks response: %w", err)
 	}
@@ -43,11 +43,7 @@ func lookupUserBookmarks(ctx context.Context, c *client, userID string, opt ...*
 		return nil, fmt.Errorf("lookup user bookmarks decode: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &lookupUserBookmarks, &HTTPError{
-			APIName: "lookup user bookmarks",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &lookupUserBookmarks, newAPIError("lookup user bookmarks", req, resp)
 	}
 
 	return &lookupUserBookmarks, nil
@@ -60,7 +56,7 @@ func bookmarkTweet(ctx context.Context, c *client, userID string, body *Bookmark
 	if body.TweetID == "" {
 		return nil, errors.New("bookmark tweet: tweet id parameter is required")
 	}
-	ep := fmt.Sprintf(bookmarkTweetURL, userID)
+	ep := c.baseURL + fmt.Sprintf(bookmarkTweetURL, userID)
 
 	j, err := json.Marshal(body)
 	if err != nil {
@@ -74,7 +70,7 @@ func bookmarkTweet(ctx context.Context, c *client, userID string, body *Bookmark
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.bearerToken))
 	req.Header.Set("Content-type", "application/json")
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("bookmark tweet response: %w", err)
 	}
@@ -85,11 +81,7 @@ func bookmarkTweet(ctx context.Context, c *client, userID string, body *Bookmark
 		return nil, fmt.Errorf("bookmark tweet decode: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &bookmarkTweet, &HTTPError{
-			APIName: "bookmark tweet",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &bookmarkTweet, newAPIError("bookmark tweet", req, resp)
 	}
 
 	return &bookmarkTweet, nil
@@ -102,7 +94,7 @@ func removeBookmarkOfTweet(ctx context.Context, c *client, userID string, tweetI
 	if tweetID == "" {
 		return nil, errors.New("remove bookmark of tweet: tweet id parameter is required")
 	}
-	ep := fmt.Sprintf(removeBookmarkOfTweetURL, userID, tweetID)
+	ep := c.baseURL + fmt.Sprintf(removeBookmarkOfTweetURL, userID, tweetID)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, ep, nil)
 	if err != nil {
@@ -110,7 +102,7 @@ func removeBookmarkOfTweet(ctx context.Context, c *client, userID string, tweetI
 	}
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.bearerToken))
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("remove bookmark of tweet response: %w", err)
 	}
@@ -121,11 +113,7 @@ func removeBookmarkOfTweet(ctx context.Context, c *client, userID string, tweetI
 		return nil, fmt.Errorf("remove bookmark of tweet decode: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &removeBookmarkOfTweet, &HTTPError{
-			APIName: "remove bookmark of tweet",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &removeBookmarkOfTweet, newAPIError("remove bookmark of tweet", req, resp)
 	}
 
 	return &removeBookmarkOfTweet, nil
@@ -159,31 +159,31 @@ func Test_LookupUserBookmarks(t *testing.T) {
 			want: &gotwtr.LookupUserBookmarksResponse{
 				Tweets: []*gotwtr.Tweet{
 					{
-						CreatedAt: "2021-02-18T17:12:47.000Z",
+						CreatedAt: mustParseTime("2021-02-18T17:12:47.000Z"),
 						Source:    "Twitter Web App",
 						ID:        "1362449997430542337",
 						Text:      "Honored to be the first developer to be featured in @TwitterDev's love fest 🥰♥️😍 https://t.co/g8TsPoZsij",
 					},
 					{
-						CreatedAt: "2021-02-26T21:38:43.000Z",
+						CreatedAt: mustParseTime("2021-02-26T21:38:43.000Z"),
 						Source:    "Twitter Web App",
 						ID:        "1365416026435854338",
 						Text:      "We're so happy for our Official Partner @Brandwatch and their big news. https://t.co/3DwWBNSq0o https://t.co/bDUGbgPkKO",
 					},
 					{
-						CreatedAt: "2020-08-20T16:41:00.000Z",
+						CreatedAt: mustParseTime("2020-08-20T16:41:00.000Z"),
 						Source:    "Twitter Web App",
 						ID:        "1296487407475462144",
 						Text:      "Check out this feature on @TwitterDev to learn more about how we're mining social media data to make sense of this evolving #publichealth crisis https://t.co/sIFLXRSvEX.",
 					},
 					{
-						CreatedAt: "2020-08-14T18:55:42.000Z",
+						CreatedAt: mustParseTime("2020-08-14T18:55:42.000Z"),
 						Source:    "Twitter for Android",
 						ID:        "1294346980072624128",
 						Text:      "I awake from five years of slumber https://t.co/OEPVyAFcfB",
 					},
 					{
-						CreatedAt: "2020-07-14T21:38:10.000Z",
+						CreatedAt: mustParseTime("2020-07-14T21:38:10.000Z"),
 						Source:    "Twitter for  iPhone",
 						ID:        "1283153843367206912",
 						Text:      "@wongmjane Wish we could tell you more, but I’m only a teapot 👀",
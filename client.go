@@ -2,7 +2,12 @@ package gotwtr
 
 import (
 	"context"
+	"fmt"
+	"io"
 	"net/http"
+	"strings"
+	"sync"
+	"time"
 )
 
 const (
@@ -16,7 +21,10 @@ const (
 
 type OAuth interface {
 	GenerateAppOnlyBearerToken(ctx context.Context) (bool, error)
-	// TODO: oauth2/invalidate_token
+	ExchangeOAuth2Code(ctx context.Context, cfg *OAuth2Config, code string, codeVerifier string) (*OAuth2Token, error)
+	RefreshOAuth2Token(ctx context.Context, cfg *OAuth2Config, refreshToken string) (*OAuth2Token, error)
+	RevokeOAuth2Token(ctx context.Context, cfg *OAuth2Config, token string, tokenTypeHint string) (*RevokeOAuth2TokenResponse, error)
+	InvalidateToken(ctx context.Context) (bool, error)
 }
 
 type Tweets interface {
@@ -38,7 +46,8 @@ type Tweets interface {
 	// Manage Tweets
 	DeleteTweet(ctx context.Context, tweetID string) (*DeleteTweetResponse, error)
 	PostTweet(ctx context.Context, body *PostTweetOption) (*PostTweetResponse, error)
-	// TODO: /2/tweets/:id/quote_tweets
+	// Quote Tweets
+	QuoteTweets(ctx context.Context, tweetID string, opt ...*QuoteTweetsOption) (*QuoteTweetsResponse, error)
 	// Retweets
 	UndoRetweet(ctx context.Context, userID string, sourceTweetID string) (*UndoRetweetResponse, error)
 	RetweetsLookup(ctx context.Context, tweetID string, opt ...*RetweetsLookupOption) (*RetweetsResponse, error)
@@ -48,7 +57,7 @@ type Tweets interface {
 	SearchRecentTweets(ctx context.Context, tweet string, opt ...*SearchTweetsOption) (*SearchTweetsResponse, error)
 	// Timelines
 	UserMentionTimeline(ctx context.Context, userID string, opt ...*UserMentionTimelineOption) (*UserMentionTimelineResponse, error)
-	// TODO: /2/users/:id/timelines/reverse_chronological
+	HomeTimeline(ctx context.Context, userID string, opt ...*HomeTimelineOption) (*HomeTimelineResponse, error)
 	UserTweetTimeline(ctx context.Context, userID string, opt ...*UserTweetTimelineOption) (*UserTweetTimelineResponse, error)
 	// Tweet counts
 	CountAllTweets(ctx context.Context, tweet string, opt ...*TweetCountsAllOption) (*TweetCountsResponse, error)
@@ -58,7 +67,7 @@ type Tweets interface {
 	RetrieveSingleTweet(ctx context.Context, tweetID string, opt ...*RetriveTweetOption) (*TweetResponse, error)
 	// Volume stream
 	VolumeStreams(ctx context.Context, ch chan<- VolumeStreamsResponse, errCh chan<- error, opt ...*VolumeStreamsOption) *VolumeStreams
-	// TODO: /2/tweets/sample10/stream
+	VolumeStreams10(ctx context.Context, partition int, ch chan<- VolumeStreamsResponse, errCh chan<- error, opt ...*VolumeStreamsOption) *VolumeStreams
 }
 
 type Users interface {
@@ -90,7 +99,7 @@ type Spaces interface {
 	LookUpSpaces(ctx context.Context, spaceIDs []string, opt ...*SpaceOption) (*SpacesResponse, error)
 	LookUpSpace(ctx context.Context, spaceID string, opt ...*SpaceOption) (*SpaceResponse, error)
 	UsersPurchasedSpaceTicket(ctx context.Context, spaceID string, opt ...*UsersPurchasedSpaceTicketOption) (*UsersPurchasedSpaceTicketResponse, error)
-	// TODO: /2/spaces/:id/tweets
+	SpaceTweets(ctx context.Context, spaceID string, opt ...*SpaceTweetsOption) (*SpaceTweetsResponse, error)
 	DiscoverSpaces(ctx context.Context, userIDs []string, opt ...*DiscoverSpacesOption) (*DiscoverSpacesResponse, error)
 }
 
@@ -123,7 +132,7 @@ type Lists interface {
 type Compliances interface {
 	// Batch compliance
 	ComplianceJobs(ctx context.Context, opt *ComplianceJobsOption) (*ComplianceJobsResponse, error)
-	ComplianceJob(ctx context.Context, complianceJobID int) (*ComplianceJobResponse, error)
+	ComplianceJob(ctx context.Context, complianceJobID string) (*ComplianceJobResponse, error)
 	CreateComplianceJob(ctx context.Context, opt ...*CreateComplianceJobOption) (*CreateComplianceJobResponse, error)
 }
 
@@ -137,6 +146,17 @@ type DirectMessages interface {
 	LookUpAllDM(ctx context.Context, opt ...*DirectMessageOption) (*LookUpAllDMResponse, error)
 }
 
+type MediaUploads interface {
+	// Media upload
+	UploadMedia(ctx context.Context, mediaType string, media io.Reader, totalBytes int64, opt ...*UploadMediaOption) (*MediaUploadResponse, error)
+	InitMediaUpload(ctx context.Context, mediaType string, totalBytes int64, opt ...*InitMediaUploadOption) (*MediaUploadResponse, error)
+	AppendMediaUpload(ctx context.Context, mediaID string, segmentIndex int, chunk []byte) error
+	FinalizeMediaUpload(ctx context.Context, mediaID string) (*MediaUploadResponse, error)
+	MediaUploadStatus(ctx context.Context, mediaID string) (*MediaUploadResponse, error)
+	CreateMediaMetadata(ctx context.Context, mediaID string, altText string) error
+	CreateMediaSubtitles(ctx context.Context, mediaID string, subtitles []*MediaSubtitle) error
+}
+
 // Twtr is a main interface for all Twitter API calls.
 type Twtr interface {
 	OAuth
@@ -146,13 +166,27 @@ type Twtr interface {
 	Lists
 	Compliances
 	DirectMessages
+	MediaUploads
 }
 
 type client struct {
 	consumerKey    string
 	consumerSecret string
-	bearerToken    string
-	client         *http.Client
+	// accessToken and accessTokenSecret sign requests with OAuth 1.0a.
+	accessToken       string
+	accessTokenSecret string
+	// bearerToken is the token given to New. Endpoints send it to mark the requests which need credentials,
+	// which auth replaces with the current ones, so it is never updated.
+	bearerToken     string
+	baseURL         string
+	uploadURL       string
+	authURL         string
+	client          *http.Client
+	rateLimitPolicy RateLimitPolicy
+	rateLimits      rateLimits
+	retryPolicy     *RetryPolicy
+	authMu          sync.RWMutex
+	auth            Authenticator
 }
 
 // Client is an API client for Twitter v2 API.
@@ -182,26 +216,144 @@ func WithHTTPClient(httpClient *http.Client) ClientOption {
 	}
 }
 
+// WithBaseURL sets the scheme and host used for every Twitter v2 API endpoint.
+// It is useful to target a local stand-in server, a recording proxy or a regional gateway.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *client) {
+		c.baseURL = strings.TrimSuffix(baseURL, "/")
+	}
+}
+
+// WithUploadURL sets the scheme and host used for media upload endpoints.
+func WithUploadURL(uploadURL string) ClientOption {
+	return func(c *client) {
+		c.uploadURL = strings.TrimSuffix(uploadURL, "/")
+	}
+}
+
+// WithAuthURL sets the scheme and host used for authentication endpoints.
+func WithAuthURL(authURL string) ClientOption {
+	return func(c *client) {
+		c.authURL = strings.TrimSuffix(authURL, "/")
+	}
+}
+
 func New(bearerToken string, opts ...ClientOption) *Client {
 	c := &client{
 		consumerKey:    "",
 		consumerSecret: "",
 		bearerToken:    bearerToken,
+		baseURL:        defaultBaseURL,
+		uploadURL:      defaultUploadURL,
+		authURL:        defaultAuthURL,
 		client:         http.DefaultClient,
 	}
 	for _, opt := range opts {
 		opt(c)
 	}
+	if c.auth == nil {
+		if c.accessToken != "" {
+			c.auth = NewOAuth1Authenticator(c.consumerKey, c.consumerSecret, c.accessToken, c.accessTokenSecret)
+		} else {
+			c.auth = NewBearerTokenAuthenticator(bearerToken)
+		}
+	}
 	return &Client{
 		client: c,
 	}
 }
 
+// do sends req and keeps track of the rate limit headers of the response.
+// Every endpoint sends its requests through do.
+func (c *client) do(req *http.Request) (*http.Response, error) {
+	endpoint := rateLimitKey(req)
+	resp, err := c.doWithRetry(req, endpoint, func() (*http.Response, error) {
+		return c.send(req, endpoint)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := bufferErrorBody(resp); err != nil {
+		return nil, fmt.Errorf("read error response: %w", err)
+	}
+	return resp, nil
+}
+
+// send sends req once, applying the rate limit policy of c.
+func (c *client) send(req *http.Request, endpoint string) (*http.Response, error) {
+	for {
+		if err := c.waitRateLimit(req, endpoint); err != nil {
+			return nil, err
+		}
+		if err := c.authorize(req); err != nil {
+			return nil, err
+		}
+		resp, err := c.client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		w, ok := c.rateLimits.update(endpoint, resp)
+		if resp.StatusCode != http.StatusTooManyRequests || !ok || !time.Now().Before(w.Reset) {
+			return resp, nil
+		}
+		switch c.rateLimitPolicy {
+		case RateLimitPolicyWait:
+			if req.Body != nil && req.GetBody == nil {
+				// the body has already been consumed and can not be sent again.
+				return resp, nil
+			}
+			resp.Body.Close()
+			if err := rewindBody(req); err != nil {
+				return nil, err
+			}
+		case RateLimitPolicyFailFast:
+			resp.Body.Close()
+			return nil, &RateLimitError{
+				Endpoint: endpoint,
+				Limit:    w.Limit,
+				Reset:    w.Reset,
+			}
+		default:
+			return resp, nil
+		}
+	}
+}
+
 // GenerateAppOnlyBearerToken generates a bearer token for app-only auth.
 func (c *client) GenerateAppOnlyBearerToken(ctx context.Context) (bool, error) {
 	return generateAppOnlyBearerToken(ctx, c)
 }
 
+// InvalidateToken invalidates the app-only bearer token of the client.
+func (c *client) InvalidateToken(ctx context.Context) (bool, error) {
+	return invalidateToken(ctx, c)
+}
+
+// SetAuthenticator replaces the authenticator of the client. It is safe to call while requests are in flight.
+func (c *Client) SetAuthenticator(a Authenticator) {
+	c.setAuthenticator(a)
+}
+
+// SetBearerToken replaces the bearer token of the client. It is safe to call while requests are in flight.
+func (c *Client) SetBearerToken(token string) {
+	c.setBearerToken(token)
+}
+
+// ExchangeOAuth2Code exchanges the authorization code, which the user was redirected with, for an access token.
+func (c *Client) ExchangeOAuth2Code(ctx context.Context, cfg *OAuth2Config, code string, codeVerifier string) (*OAuth2Token, error) {
+	return exchangeOAuth2Code(ctx, c.client, cfg, code, codeVerifier)
+}
+
+// RefreshOAuth2Token obtains a new access token and a rotated refresh token.
+func (c *Client) RefreshOAuth2Token(ctx context.Context, cfg *OAuth2Config, refreshToken string) (*OAuth2Token, error) {
+	return refreshOAuth2Token(ctx, c.client, cfg, refreshToken)
+}
+
+// RevokeOAuth2Token revokes an access token or a refresh token. tokenTypeHint is access_token, refresh_token or empty.
+func (c *Client) RevokeOAuth2Token(ctx context.Context, cfg *OAuth2Config, token string, tokenTypeHint string) (*RevokeOAuth2TokenResponse, error) {
+	return revokeOAuth2Token(ctx, c.client, cfg, token, tokenTypeHint)
+}
+
 // RetrieveMultipleTweets returns a variety of information about the Tweet specified by the requested ID or list of IDs.
 func (c *Client) RetrieveMultipleTweets(ctx context.Context, tweetIDs []string, opt ...*RetriveTweetOption) (*TweetsResponse, error) {
 	return retrieveMultipleTweets(ctx, c.client, tweetIDs, opt...)
@@ -212,6 +364,17 @@ func (c *Client) RetrieveSingleTweet(ctx context.Context, tweetID string, opt ..
 	return retrieveSingleTweet(ctx, c.client, tweetID, opt...)
 }
 
+// FetchConversation fetches every Tweet of the conversation of tweetID and builds its reply tree.
+func (c *Client) FetchConversation(ctx context.Context, tweetID string, opt ...*FetchConversationOption) (*Conversation, error) {
+	return fetchConversation(ctx, c, tweetID, opt...)
+}
+
+// HomeTimeline returns the Tweets and Retweets posted by the user and the users they follow, in reverse chronological order.
+// It requires user context authentication.
+func (c *Client) HomeTimeline(ctx context.Context, userID string, opt ...*HomeTimelineOption) (*HomeTimelineResponse, error) {
+	return homeTimeline(ctx, c.client, userID, opt...)
+}
+
 // UserMentionTimeline returns Tweets mentioning a single user specified by the requested userID.
 // By default, the most recent ten Tweets are returned per request. Using pagination, up to the most recent 800 Tweets can be retrieved.
 func (c *Client) UserMentionTimeline(ctx context.Context, userID string, opt ...*UserMentionTimelineOption) (*UserMentionTimelineResponse, error) {
@@ -287,6 +450,13 @@ func (c *Client) RetrieveStreamRules(ctx context.Context, opt ...*RetrieveStream
 	return retrieveStreamRules(ctx, c.client, opt...)
 }
 
+// SyncStreamRules makes the rules of the filtered stream match desired, comparing rules by value and tag.
+// Rules which are not desired are deleted and missing ones are added, so applying the same rules twice changes nothing.
+// A value may only be given with one tag, as Twitter allows a single rule per value.
+func (c *Client) SyncStreamRules(ctx context.Context, desired []*AddRule, opt ...*SyncStreamRulesOption) (*SyncStreamRulesReport, error) {
+	return syncStreamRules(ctx, c.client, desired, opt...)
+}
+
 // ConnectToStream streams Tweets in real-time based on a specific set of filter rules.
 func (c *Client) ConnectToStream(ctx context.Context, ch chan<- ConnectToStreamResponse, errCh chan<- error, opt ...*ConnectToStreamOption) *ConnectToStream {
 	return connectToStream(ctx, c.client, ch, errCh, opt...)
@@ -297,6 +467,16 @@ func (c *Client) VolumeStreams(ctx context.Context, ch chan<- VolumeStreamsRespo
 	return volumeStreams(ctx, c.client, ch, errCh, opt...)
 }
 
+// VolumeStreams10 streams one partition of a 10% sample of all Tweets in real-time. partition is 1 or 2.
+func (c *Client) VolumeStreams10(ctx context.Context, partition int, ch chan<- VolumeStreamsResponse, errCh chan<- error, opt ...*VolumeStreamsOption) *VolumeStreams {
+	return volumeStreams10(ctx, c.client, partition, ch, errCh, opt...)
+}
+
+// QuoteTweets returns Quote Tweets for a Tweet specified by the requested Tweet ID.
+func (c *Client) QuoteTweets(ctx context.Context, tweetID string, opt ...*QuoteTweetsOption) (*QuoteTweetsResponse, error) {
+	return quoteTweets(ctx, c.client, tweetID, opt...)
+}
+
 // RetweetsLookup allows you to get information about who has Retweeted a Tweet.
 func (c *Client) RetweetsLookup(ctx context.Context, tweetID string, opt ...*RetweetsLookupOption) (*RetweetsResponse, error) {
 	return retweetsLookup(ctx, c.client, tweetID, opt...)
@@ -427,6 +607,11 @@ func (c *Client) UsersPurchasedSpaceTicket(ctx context.Context, spaceID string,
 	return usersPurchasedSpaceTicket(ctx, c.client, spaceID, opt...)
 }
 
+// SpaceTweets returns Tweets shared in the requested Space.
+func (c *Client) SpaceTweets(ctx context.Context, spaceID string, opt ...*SpaceTweetsOption) (*SpaceTweetsResponse, error) {
+	return spaceTweets(ctx, c.client, spaceID, opt...)
+}
+
 // DiscoverSpaces returns live or scheduled Spaces created by the specified userIDs.
 // Up to 100 comma-separated IDs can be looked up using this endpoint.
 func (c *Client) DiscoverSpaces(ctx context.Context, userIDs []string, opt ...*DiscoverSpacesOption) (*DiscoverSpacesResponse, error) {
@@ -526,7 +711,7 @@ func (c *Client) ComplianceJobs(ctx context.Context, opt *ComplianceJobsOption)
 }
 
 // ComplianceJob returns a single compliance job with the specified ID.
-func (c *Client) ComplianceJob(ctx context.Context, complianceJobID int) (*ComplianceJobResponse, error) {
+func (c *Client) ComplianceJob(ctx context.Context, complianceJobID string) (*ComplianceJobResponse, error) {
 	return complianceJob(ctx, c.client, complianceJobID)
 }
 
@@ -535,6 +720,11 @@ func (c *Client) CreateComplianceJob(ctx context.Context, opt ...*CreateComplian
 	return createComplianceJob(ctx, c.client, opt...)
 }
 
+// RunComplianceJob creates a compliance job, uploads the newline separated ids, waits until the job is complete and returns its results.
+func (c *Client) RunComplianceJob(ctx context.Context, typ ComplianceFieldType, ids io.Reader, opt ...*RunComplianceJobOption) (*ComplianceResults, error) {
+	return runComplianceJob(ctx, c.client, typ, ids, opt...)
+}
+
 // UpdateMetaDataForList enables the authenticated user to update the meta data of a specified List that they own.
 func (c *Client) UpdateMetaDataForList(ctx context.Context, listID string, body ...*UpdateMetaDataForListBody) (*UpdateMetaDataForListResponse, error) {
 	return updateMetaDataForList(ctx, c.client, listID, body...)
@@ -573,3 +763,39 @@ func (c *Client) LookUpDM(ctx context.Context, dmConversationID string, opt ...*
 func (c *Client) LookUpAllDM(ctx context.Context, opt ...*DirectMessageOption) (*LookUpAllDMResponse, error) {
 	return lookUpAllDM(ctx, c.client, opt...)
 }
+
+// UploadMedia uploads media of the MIME type mediaType in chunks and waits until Twitter has processed it.
+// The returned MediaIDString can be used in PostTweetOption.Media and DirectMessageAttachment.
+func (c *Client) UploadMedia(ctx context.Context, mediaType string, media io.Reader, totalBytes int64, opt ...*UploadMediaOption) (*MediaUploadResponse, error) {
+	return uploadMedia(ctx, c.client, mediaType, media, totalBytes, opt...)
+}
+
+// InitMediaUpload starts a chunked media upload with the INIT command.
+func (c *Client) InitMediaUpload(ctx context.Context, mediaType string, totalBytes int64, opt ...*InitMediaUploadOption) (*MediaUploadResponse, error) {
+	return initMediaUpload(ctx, c.client, mediaType, totalBytes, opt...)
+}
+
+// AppendMediaUpload uploads a chunk of at most 5MB of a media with the APPEND command.
+func (c *Client) AppendMediaUpload(ctx context.Context, mediaID string, segmentIndex int, chunk []byte) error {
+	return appendMediaUpload(ctx, c.client, mediaID, segmentIndex, chunk)
+}
+
+// FinalizeMediaUpload completes a chunked media upload with the FINALIZE command.
+func (c *Client) FinalizeMediaUpload(ctx context.Context, mediaID string) (*MediaUploadResponse, error) {
+	return finalizeMediaUpload(ctx, c.client, mediaID)
+}
+
+// MediaUploadStatus returns the processing state of an uploaded media with the STATUS command.
+func (c *Client) MediaUploadStatus(ctx context.Context, mediaID string) (*MediaUploadResponse, error) {
+	return mediaUploadStatus(ctx, c.client, mediaID)
+}
+
+// CreateMediaMetadata sets the alt text of an uploaded media.
+func (c *Client) CreateMediaMetadata(ctx context.Context, mediaID string, altText string) error {
+	return createMediaMetadata(ctx, c.client, mediaID, altText)
+}
+
+// CreateMediaSubtitles attaches uploaded subtitles to an uploaded video.
+func (c *Client) CreateMediaSubtitles(ctx context.Context, mediaID string, subtitles []*MediaSubtitle) error {
+	return createMediaSubtitles(ctx, c.client, mediaID, subtitles)
+}
@@ -0,0 +1,72 @@
+package gotwtr_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sivchari/gotwtr"
+)
+
+func Test_WithBaseURL(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		path    string
+		call    func(ctx context.Context, c *gotwtr.Client) error
+		wantErr bool
+	}{
+		{
+			name: "retrieve single tweet",
+			path: "/2/tweets/1",
+			call: func(ctx context.Context, c *gotwtr.Client) error {
+				_, err := c.RetrieveSingleTweet(ctx, "1")
+				return err
+			},
+			wantErr: false,
+		},
+		{
+			name: "followers",
+			path: "/2/users/2244994945/followers",
+			call: func(ctx context.Context, c *gotwtr.Client) error {
+				_, err := c.Followers(ctx, "2244994945")
+				return err
+			},
+			wantErr: false,
+		},
+		{
+			name: "generate app only bearer token",
+			path: "/oauth2/token",
+			call: func(ctx context.Context, c *gotwtr.Client) error {
+				_, err := c.GenerateAppOnlyBearerToken(ctx)
+				return err
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != tt.path {
+					w.WriteHeader(http.StatusNotFound)
+				}
+				_, _ = io.WriteString(w, `{}`)
+			}))
+			defer srv.Close()
+			c := gotwtr.New(
+				"key",
+				gotwtr.WithBaseURL(srv.URL+"/"),
+				gotwtr.WithAuthURL(srv.URL),
+				gotwtr.WithConsumerKey("consumerKey"),
+				gotwtr.WithConsumerSecret("consumerSecret"),
+			)
+			if err := tt.call(context.Background(), c); (err != nil) != tt.wantErr {
+				t.Errorf("call to %s error = %v, wantErr %v", tt.path, err, tt.wantErr)
+			}
+		})
+	}
+}
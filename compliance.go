@@ -23,13 +23,13 @@ type ComplianceJobsResponse struct {
 
 type ComplianceJobsData struct {
 	ID                string `json:"id"`
-	CreatedAt         string `json:"created_at"`
+	CreatedAt         Time   `json:"created_at"`
 	Type              string `json:"type"`
 	Name              string `json:"name"`
 	UploadURL         string `json:"upload_url"`
-	UploadExpiresAt   string `json:"upload_expires_at"`
+	UploadExpiresAt   Time   `json:"upload_expires_at"`
 	DownloadURL       string `json:"download_url"`
-	DownloadExpiresAt string `json:"download_expires_at"`
+	DownloadExpiresAt Time   `json:"download_expires_at"`
 	Status            string `json:"status"`
 	Resumable         bool   `json:"resumable"`
 	Error             string `json:"error,omitempty"`
@@ -42,13 +42,13 @@ type ComplianceJobResponse struct {
 
 type ComplianceJobData struct {
 	ID                string `json:"id"`
-	CreatedAt         string `json:"created_at"`
+	CreatedAt         Time   `json:"created_at"`
 	Type              string `json:"type"`
 	Name              string `json:"name"`
 	UploadURL         string `json:"upload_url"`
-	UploadExpiresAt   string `json:"upload_expires_at"`
+	UploadExpiresAt   Time   `json:"upload_expires_at"`
 	DownloadURL       string `json:"download_url"`
-	DownloadExpiresAt string `json:"download_expires_at"`
+	DownloadExpiresAt Time   `json:"download_expires_at"`
 	Status            string `json:"status"`
 	Resumable         bool   `json:"resumable"`
 	Error             string `json:"error,omitempty"`
@@ -61,14 +61,24 @@ type CreateComplianceJobResponse struct {
 
 type CreateComplianceJobData struct {
 	ID                string `json:"id"`
-	CreatedAt         string `json:"created_at"`
+	CreatedAt         Time   `json:"created_at"`
 	Type              string `json:"type"`
 	Name              string `json:"name"`
 	UploadURL         string `json:"upload_url"`
-	UploadExpiresAt   string `json:"upload_expires_at"`
+	UploadExpiresAt   Time   `json:"upload_expires_at"`
 	DownloadURL       string `json:"download_url"`
-	DownloadExpiresAt string `json:"download_expires_at"`
+	DownloadExpiresAt Time   `json:"download_expires_at"`
 	Status            string `json:"status"`
 	Resumable         bool   `json:"resumable"`
 	Error             string `json:"error,omitempty"`
 }
+
+// ComplianceResult is a line of the result file of a compliance job: a Tweet or user which must be
+// removed from, or updated in, the caller's storage.
+type ComplianceResult struct {
+	ID         string `json:"id"`
+	Action     string `json:"action"`
+	CreatedAt  Time   `json:"created_at"`
+	RedactedAt Time   `json:"redacted_at,omitempty"`
+	Reason     string `json:"reason"`
+}
@@ -0,0 +1,211 @@
+package gotwtr
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const (
+	defaultCompliancePollInterval    = 5 * time.Second
+	defaultComplianceMaxPollInterval = time.Minute
+)
+
+var (
+	// ErrComplianceJobFailed is returned by RunComplianceJob when the job ends with the failed status.
+	ErrComplianceJobFailed = errors.New("compliance job failed")
+	// ErrComplianceJobExpired is returned by RunComplianceJob when the upload or download location of the job has expired.
+	ErrComplianceJobExpired = errors.New("compliance job expired")
+)
+
+// ComplianceResults iterates over the result file of a compliance job.
+//
+//	results, err := client.RunComplianceJob(ctx, gotwtr.ComplianceFieldTypeTweets, ids)
+//	if err != nil {
+//		return err
+//	}
+//	defer results.Close()
+//	for results.Next() {
+//		r := results.Result()
+//		...
+//	}
+//	if err := results.Err(); err != nil {
+//		return err
+//	}
+type ComplianceResults struct {
+	// Job is the completed compliance job.
+	Job *ComplianceJobData
+
+	body   io.ReadCloser
+	dec    *json.Decoder
+	result *ComplianceResult
+	err    error
+}
+
+// Next decodes the next result. It returns false at the end of the file or when an error occurred.
+func (r *ComplianceResults) Next() bool {
+	if r.err != nil {
+		return false
+	}
+	var result ComplianceResult
+	if err := r.dec.Decode(&result); err != nil {
+		if err != io.EOF {
+			r.err = fmt.Errorf("run compliance job decode: %w", err)
+		}
+		r.result = nil
+		return false
+	}
+	r.result = &result
+	return true
+}
+
+// Result returns the result decoded by the last call to Next.
+func (r *ComplianceResults) Result() *ComplianceResult {
+	return r.result
+}
+
+// Err returns the error which stopped the iteration, if any.
+func (r *ComplianceResults) Err() error {
+	return r.err
+}
+
+// Close closes the result file.
+func (r *ComplianceResults) Close() error {
+	return r.body.Close()
+}
+
+func runComplianceJob(ctx context.Context, c *client, typ ComplianceFieldType, ids io.Reader, opt ...*RunComplianceJobOption) (*ComplianceResults, error) {
+	var ropt RunComplianceJobOption
+	switch len(opt) {
+	case 0:
+		// do nothing
+	case 1:
+		ropt = *opt[0]
+	default:
+		return nil, errors.New("run compliance job: only one option is allowed")
+	}
+	if ids == nil {
+		return nil, errors.New("run compliance job: ids parameter is required")
+	}
+	interval := ropt.PollInterval
+	if interval <= 0 {
+		interval = defaultCompliancePollInterval
+	}
+	maxInterval := ropt.MaxPollInterval
+	if maxInterval <= 0 {
+		maxInterval = defaultComplianceMaxPollInterval
+	}
+
+	created, err := createComplianceJob(ctx, c, &CreateComplianceJobOption{
+		Type:      typ,
+		Name:      ropt.Name,
+		Resumable: ropt.Resumable,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("run compliance job: %w", err)
+	}
+	if created.CreateComplianceJobData == nil {
+		return nil, fmt.Errorf("run compliance job: %w", noDataError("create compliance job", created.Errors))
+	}
+	job := ComplianceJobData(*created.CreateComplianceJobData)
+	if err := checkComplianceExpiry(job.ID, "upload", job.UploadExpiresAt); err != nil {
+		return nil, err
+	}
+	if err := uploadComplianceIDs(ctx, c, job.UploadURL, ids); err != nil {
+		return nil, err
+	}
+
+	for {
+		resp, err := complianceJob(ctx, c, job.ID)
+		if err != nil {
+			return nil, fmt.Errorf("run compliance job: %w", err)
+		}
+		if resp.ComplianceJobData == nil {
+			return nil, fmt.Errorf("run compliance job: %w", noDataError("compliance job", resp.Errors))
+		}
+		job = *resp.ComplianceJobData
+		if ropt.OnStatus != nil {
+			ropt.OnStatus(&job)
+		}
+		switch ComplianceFieldStatus(job.Status) {
+		case ComplianseFieldStatusCompletae:
+			return downloadComplianceResults(ctx, c, &job)
+		case ComplianseFieldStatusFailed:
+			return nil, fmt.Errorf("run compliance job %v: %w: %s", job.ID, ErrComplianceJobFailed, job.Error)
+		}
+		if err := sleepUntil(ctx, time.Now().Add(interval)); err != nil {
+			return nil, err
+		}
+		if interval *= 2; interval > maxInterval {
+			interval = maxInterval
+		}
+	}
+}
+
+// uploadComplianceIDs sends the newline separated IDs to the pre-signed upload location of a job,
+// which must not receive the credentials of the client.
+func uploadComplianceIDs(ctx context.Context, c *client, uploadURL string, ids io.Reader) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, uploadURL, ids)
+	if err != nil {
+		return fmt.Errorf("run compliance job upload new request with ctx: %w", err)
+	}
+	req.Header.Set("Content-Type", "text/plain")
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("run compliance job upload: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	// pre-signed storage locations may answer 201 or 204 as well.
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("run compliance job upload: unexpected status %s", resp.Status)
+	}
+	return nil
+}
+
+func downloadComplianceResults(ctx context.Context, c *client, job *ComplianceJobData) (*ComplianceResults, error) {
+	if err := checkComplianceExpiry(job.ID, "download", job.DownloadExpiresAt); err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, job.DownloadURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("run compliance job download new request with ctx: %w", err)
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("run compliance job download: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("run compliance job download: unexpected status %s", resp.Status)
+	}
+	return &ComplianceResults{
+		Job:  job,
+		body: resp.Body,
+		dec:  json.NewDecoder(resp.Body),
+	}, nil
+}
+
+// checkComplianceExpiry returns ErrComplianceJobExpired when expiresAt has passed. A missing expiry is left
+// to the upload or download location to enforce.
+func checkComplianceExpiry(jobID, location string, expiresAt Time) error {
+	if expiresAt.IsZero() {
+		return nil
+	}
+	if !time.Now().Before(expiresAt.Time) {
+		return fmt.Errorf("run compliance job %v: %w: %s location expired at %s", jobID, ErrComplianceJobExpired, location, expiresAt)
+	}
+	return nil
+}
+
+// noDataError returns the first error of a response which has no data.
+func noDataError(apiName string, errs []*APIResponseError) error {
+	if len(errs) == 0 {
+		return fmt.Errorf("%s: no data in response", apiName)
+	}
+	return fmt.Errorf("%s: %s", apiName, errs[0].Detail)
+}
@@ -0,0 +1,122 @@
+package gotwtr_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sivchari/gotwtr"
+	"github.com/sivchari/gotwtr/gotwtrtest"
+)
+
+func Test_RunComplianceJob(t *testing.T) {
+	t.Parallel()
+	srv := gotwtrtest.NewServer()
+	defer srv.Close()
+	c := srv.Client()
+	ctx := context.Background()
+	tw := srv.AddTweet(&gotwtr.Tweet{Text: "still here"})
+
+	var statuses []string
+	results, err := c.RunComplianceJob(ctx, gotwtr.ComplianceFieldTypeTweets, strings.NewReader(tw.ID+"\n1\n2\n"), &gotwtr.RunComplianceJobOption{
+		Name: "nightly",
+		OnStatus: func(job *gotwtr.ComplianceJobData) {
+			statuses = append(statuses, job.Status)
+		},
+	})
+	if err != nil {
+		t.Fatalf("RunComplianceJob() error = %v", err)
+	}
+	defer results.Close()
+	var ids []string
+	for results.Next() {
+		r := results.Result()
+		if r.Action != "delete" || r.RedactedAt.IsZero() {
+			t.Errorf("ComplianceResults.Result() = %+v, want a redacted delete", r)
+		}
+		ids = append(ids, r.ID)
+	}
+	if err := results.Err(); err != nil {
+		t.Fatalf("ComplianceResults.Err() = %v", err)
+	}
+	if diff := cmp.Diff([]string{"1", "2"}, ids); diff != "" {
+		t.Errorf("RunComplianceJob() results mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"complete"}, statuses); diff != "" {
+		t.Errorf("RunComplianceJobOption.OnStatus() mismatch (-want +got):\n%s", diff)
+	}
+	if results.Job.Name != "nightly" {
+		t.Errorf("ComplianceResults.Job.Name = %q, want nightly", results.Job.Name)
+	}
+}
+
+func Test_RunComplianceJobErrors(t *testing.T) {
+	t.Parallel()
+	job := func(status, uploadExpiresAt string) string {
+		return fmt.Sprintf(`{"data": {"id": "1", "type": "tweets", "status": %q, "error": "bad file", "upload_url": "https://storage.test/upload", "upload_expires_at": %q, "download_url": "https://storage.test/download", "download_expires_at": "2999-01-01T00:00:00.000Z"}}`, status, uploadExpiresAt)
+	}
+	tests := []struct {
+		name     string
+		statuses []string
+		expires  string
+		wantErr  error
+	}{
+		{
+			name:     "failed after polling",
+			statuses: []string{"in_progress", "in_progress", "failed"},
+			expires:  "2999-01-01T00:00:00.000Z",
+			wantErr:  gotwtr.ErrComplianceJobFailed,
+		},
+		{
+			name:    "upload expired",
+			expires: "2000-01-01T00:00:00.000Z",
+			wantErr: gotwtr.ErrComplianceJobExpired,
+		},
+	}
+	for i, tt := range tests {
+		i := i
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var polls int32
+			client := mockHTTPClient(func(req *http.Request) *http.Response {
+				body := ""
+				status := http.StatusOK
+				switch {
+				case req.Method == http.MethodPost:
+					body = job("created", tt.expires)
+				case req.Method == http.MethodPut:
+					if req.Header.Get("Authorization") != "" {
+						t.Errorf("upload sent credentials")
+					}
+					// storage may answer the upload with any 2xx status.
+					status = http.StatusNoContent
+				default:
+					n := atomic.AddInt32(&polls, 1)
+					body = job(tt.statuses[n-1], tt.expires)
+				}
+				return &http.Response{
+					StatusCode: status,
+					Body:       io.NopCloser(strings.NewReader(body)),
+				}
+			})
+			c := gotwtr.New("test-key", gotwtr.WithHTTPClient(client))
+			_, err := c.RunComplianceJob(context.Background(), gotwtr.ComplianceFieldTypeTweets, strings.NewReader("1\n"), &gotwtr.RunComplianceJobOption{
+				PollInterval: time.Millisecond,
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("RunComplianceJob() index = %v error = %v, want %v", i, err, tt.wantErr)
+			}
+			if got := int(atomic.LoadInt32(&polls)); got != len(tt.statuses) {
+				t.Errorf("RunComplianceJob() index = %v polls = %d, want %d", i, got, len(tt.statuses))
+			}
+		})
+	}
+}
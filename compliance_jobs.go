@@ -23,13 +23,13 @@ func complianceJobs(ctx context.Context, c *client, opt ...*ComplianceJobsOption
 	if copt.Type == "" {
 		return nil, errors.New("compliance jobs: type parameter is required")
 	}
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, complianceJobsURL, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+complianceJobsURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("compliance jobs new request with ctx: %w", err)
 	}
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.bearerToken))
 	copt.addQuery(req)
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("compliance jobs response: %w", err)
 	}
@@ -40,23 +40,22 @@ func complianceJobs(ctx context.Context, c *client, opt ...*ComplianceJobsOption
 		return nil, fmt.Errorf("compliance jobs: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &cj, &HTTPError{
-			APIName: "compliance jobs",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &cj, newAPIError("compliance jobs", req, resp)
 	}
 	return &cj, nil
 }
 
-func complianceJob(ctx context.Context, c *client, cjID int) (*ComplianceJobResponse, error) {
-	ep := fmt.Sprintf(complianceJobURL, cjID)
+func complianceJob(ctx context.Context, c *client, cjID string) (*ComplianceJobResponse, error) {
+	if cjID == "" {
+		return nil, errors.New("compliance job: id parameter is required")
+	}
+	ep := c.baseURL + fmt.Sprintf(complianceJobURL, cjID)
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ep, nil)
 	if err != nil {
 		return nil, fmt.Errorf("compliance job new request with ctx: %w", err)
 	}
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.bearerToken))
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("compliance job response: %w", err)
 	}
@@ -67,11 +66,7 @@ func complianceJob(ctx context.Context, c *client, cjID int) (*ComplianceJobResp
 		return nil, fmt.Errorf("compliance job: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &cj, &HTTPError{
-			APIName: "compliance job",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &cj, newAPIError("compliance job", req, resp)
 	}
 	return &cj, nil
 }
@@ -93,13 +88,13 @@ func createComplianceJob(ctx context.Context, c *client, opt ...*CreateComplianc
 	if err != nil {
 		return nil, fmt.Errorf("create compliance job: can not marshal: %w", err)
 	}
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, createComplianceJobURL, bytes.NewBuffer(j))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+createComplianceJobURL, bytes.NewBuffer(j))
 	if err != nil {
 		return nil, fmt.Errorf("create compliance job new request with ctx: %w", err)
 	}
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.bearerToken))
 	req.Header.Set("Content-Type", "application/json")
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("create compliance job response: %w", err)
 	}
@@ -110,11 +105,7 @@ func createComplianceJob(ctx context.Context, c *client, opt ...*CreateComplianc
 		return nil, fmt.Errorf("create compliance job: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &cresponse, &HTTPError{
-			APIName: "create compliance job",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &cresponse, newAPIError("create compliance job", req, resp)
 	}
 	return &cresponse, nil
 }
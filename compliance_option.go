@@ -1,6 +1,9 @@
 package gotwtr
 
-import "net/http"
+import (
+	"net/http"
+	"time"
+)
 
 type ComplianceJobsOption struct {
 	Type   ComplianceFieldType
@@ -23,3 +26,14 @@ type CreateComplianceJobOption struct {
 	Name      string              `json:"name,omitempty"`
 	Resumable bool                `json:"resumable,omitempty"`
 }
+
+type RunComplianceJobOption struct {
+	Name      string
+	Resumable bool
+	// PollInterval is the first wait between two status checks, doubled after each check up to MaxPollInterval.
+	// They default to 5s and 1m.
+	PollInterval    time.Duration
+	MaxPollInterval time.Duration
+	// OnStatus is called with the job after every status check.
+	OnStatus func(job *ComplianceJobData)
+}
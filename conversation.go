@@ -0,0 +1,268 @@
+package gotwtr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/sivchari/gotwtr/snowflake"
+)
+
+// conversationTweetFields link the Tweets of a conversation together.
+var conversationTweetFields = []TweetField{
+	TweetFieldAuthorID,
+	TweetFieldConversationID,
+	TweetFieldCreatedAt,
+	TweetFieldInReplyToUserID,
+	TweetFieldReferencedTweets,
+}
+
+// ConversationNode is a Tweet of a conversation with its replies, oldest first.
+type ConversationNode struct {
+	Tweet   *Tweet              `json:"tweet"`
+	Hidden  bool                `json:"hidden,omitempty"`
+	Replies []*ConversationNode `json:"replies,omitempty"`
+	// Parent is nil for the root of the conversation and for orphans.
+	Parent *ConversationNode `json:"-"`
+	// Depth is 0 for the root of the conversation and for orphans.
+	Depth int `json:"-"`
+}
+
+// Conversation is the reply tree of a conversation.
+type Conversation struct {
+	ID string `json:"id"`
+	// Root is the Tweet which started the conversation, or nil if it could not be retrieved, e.g. deleted.
+	Root *ConversationNode `json:"root,omitempty"`
+	// Orphans are the replies which parent could not be retrieved, each with its own subtree, oldest first.
+	Orphans  []*ConversationNode `json:"orphans,omitempty"`
+	Includes *TweetIncludes      `json:"-"`
+
+	c     *Client
+	nodes map[string]*ConversationNode
+}
+
+// Node returns the node of the Tweet with the given ID, or nil if it is not in the conversation.
+func (cv *Conversation) Node(tweetID string) *ConversationNode {
+	return cv.nodes[tweetID]
+}
+
+// Len returns the number of Tweets in the conversation.
+func (cv *Conversation) Len() int {
+	return len(cv.nodes)
+}
+
+// Flatten returns the nodes in reading order: each Tweet followed by its replies, from the root then the orphans.
+// Unless includeHidden is set, hidden replies are left out with their replies, as they are when reading the conversation.
+func (cv *Conversation) Flatten(includeHidden bool) []*ConversationNode {
+	var nodes []*ConversationNode
+	var walk func(n *ConversationNode)
+	walk = func(n *ConversationNode) {
+		if n.Hidden && !includeHidden {
+			return
+		}
+		nodes = append(nodes, n)
+		for _, r := range n.Replies {
+			walk(r)
+		}
+	}
+	if cv.Root != nil {
+		walk(cv.Root)
+	}
+	for _, o := range cv.Orphans {
+		walk(o)
+	}
+	return nodes
+}
+
+// HideReply hides or unhides a reply with HideReplies and updates its node.
+func (cv *Conversation) HideReply(ctx context.Context, tweetID string, hidden bool) error {
+	n, ok := cv.nodes[tweetID]
+	if !ok {
+		return fmt.Errorf("hide conversation reply: tweet %v is not in the conversation", tweetID)
+	}
+	if n == cv.Root {
+		return errors.New("hide conversation reply: the root of a conversation is not a reply")
+	}
+	if _, err := cv.c.HideReplies(ctx, tweetID, hidden); err != nil {
+		return fmt.Errorf("hide conversation reply: %w", err)
+	}
+	n.Hidden = hidden
+	return nil
+}
+
+func fetchConversation(ctx context.Context, c *Client, tweetID string, opt ...*FetchConversationOption) (*Conversation, error) {
+	if tweetID == "" {
+		return nil, errors.New("fetch conversation: tweet id parameter is required")
+	}
+	var fopt FetchConversationOption
+	switch len(opt) {
+	case 0:
+		// do nothing
+	case 1:
+		fopt = *opt[0]
+	default:
+		return nil, errors.New("fetch conversation: only one option is allowed")
+	}
+	fields := append([]TweetField{}, fopt.TweetFields...)
+	for _, f := range conversationTweetFields {
+		if !containsTweetField(fields, f) {
+			fields = append(fields, f)
+		}
+	}
+	ropt := &RetriveTweetOption{
+		Expansions:  fopt.Expansions,
+		MediaFields: fopt.MediaFields,
+		PlaceFields: fopt.PlaceFields,
+		PollFields:  fopt.PollFields,
+		TweetFields: fields,
+		UserFields:  fopt.UserFields,
+	}
+
+	cv := &Conversation{
+		Includes: &TweetIncludes{},
+		c:        c,
+		nodes:    make(map[string]*ConversationNode),
+	}
+	add := func(tweets []*Tweet, includes *TweetIncludes) {
+		for _, t := range tweets {
+			if _, ok := cv.nodes[t.ID]; !ok {
+				cv.nodes[t.ID] = &ConversationNode{Tweet: t}
+			}
+		}
+		if includes != nil {
+			mergeTweetIncludes(cv.Includes, includes)
+		}
+	}
+
+	resp, err := c.RetrieveSingleTweet(ctx, tweetID, ropt)
+	if err != nil {
+		return nil, fmt.Errorf("fetch conversation: %w", err)
+	}
+	if resp.Tweet == nil {
+		return nil, fmt.Errorf("fetch conversation: %w", noDataError("retrieve single tweet", resp.Errors))
+	}
+	add([]*Tweet{resp.Tweet}, resp.Includes)
+	cv.ID = resp.Tweet.ConversationID
+	if cv.ID == "" {
+		cv.ID = resp.Tweet.ID
+	}
+
+	sopt := &SearchTweetsOption{
+		Expansions:  ropt.Expansions,
+		MaxResults:  100,
+		MediaFields: ropt.MediaFields,
+		PlaceFields: ropt.PlaceFields,
+		PollFields:  ropt.PollFields,
+		TweetFields: ropt.TweetFields,
+		UserFields:  ropt.UserFields,
+	}
+	query := "conversation_id:" + cv.ID
+	pager := c.SearchRecentTweetsPager(ctx, query, sopt)
+	if fopt.FullArchive {
+		pager = c.SearchAllTweetsPager(ctx, query, sopt)
+	}
+	pager.MaxPages = fopt.MaxPages
+	for pager.Next() {
+		add(pager.Page(), nil)
+	}
+	if err := pager.Err(); err != nil {
+		return nil, fmt.Errorf("fetch conversation: %w", err)
+	}
+	mergeTweetIncludes(cv.Includes, pager.Includes())
+
+	// the search misses the root and the replies older than its window, so missing parents are retrieved
+	// until every chain ends at the root or at a Tweet which is not available.
+	tried := make(map[string]bool)
+	for {
+		var missing []string
+		want := func(id string) {
+			if id == "" || tried[id] {
+				return
+			}
+			if _, ok := cv.nodes[id]; ok {
+				return
+			}
+			tried[id] = true
+			missing = append(missing, id)
+		}
+		want(cv.ID)
+		for _, n := range cv.nodes {
+			want(repliedToID(n.Tweet))
+		}
+		if len(missing) == 0 {
+			break
+		}
+		sort.Strings(missing)
+		for start := 0; start < len(missing); start += tweetLookUpMaxIDs {
+			end := start + tweetLookUpMaxIDs
+			if end > len(missing) {
+				end = len(missing)
+			}
+			resp, err := c.RetrieveMultipleTweets(ctx, missing[start:end], ropt)
+			if err != nil {
+				return nil, fmt.Errorf("fetch conversation: %w", err)
+			}
+			add(resp.Tweets, resp.Includes)
+		}
+	}
+
+	hidden := make(map[string]bool, len(fopt.HiddenReplyIDs))
+	for _, id := range fopt.HiddenReplyIDs {
+		hidden[id] = true
+	}
+	for id, n := range cv.nodes {
+		n.Hidden = hidden[id]
+		if id == cv.ID {
+			cv.Root = n
+			continue
+		}
+		if p, ok := cv.nodes[repliedToID(n.Tweet)]; ok {
+			n.Parent = p
+			p.Replies = append(p.Replies, n)
+			continue
+		}
+		cv.Orphans = append(cv.Orphans, n)
+	}
+	sortConversationNodes(cv.Orphans)
+	var setDepth func(n *ConversationNode, depth int)
+	setDepth = func(n *ConversationNode, depth int) {
+		n.Depth = depth
+		sortConversationNodes(n.Replies)
+		for _, r := range n.Replies {
+			setDepth(r, depth+1)
+		}
+	}
+	if cv.Root != nil {
+		setDepth(cv.Root, 0)
+	}
+	for _, o := range cv.Orphans {
+		setDepth(o, 0)
+	}
+	return cv, nil
+}
+
+// repliedToID returns the ID of the Tweet which t replies to, if any.
+func repliedToID(t *Tweet) string {
+	for _, ref := range t.ReferencedTweets {
+		if ref.Type == "replied_to" {
+			return ref.ID
+		}
+	}
+	return ""
+}
+
+func sortConversationNodes(nodes []*ConversationNode) {
+	sort.Slice(nodes, func(i, j int) bool {
+		return snowflake.Newer(nodes[j].Tweet.ID, nodes[i].Tweet.ID)
+	})
+}
+
+func containsTweetField(fields []TweetField, f TweetField) bool {
+	for _, v := range fields {
+		if v == f {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,118 @@
+package gotwtr_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sivchari/gotwtr"
+	"github.com/sivchari/gotwtr/gotwtrtest"
+)
+
+func Test_FetchConversation(t *testing.T) {
+	t.Parallel()
+	srv := gotwtrtest.NewServer()
+	defer srv.Close()
+	srv.SetMe(&gotwtr.User{ID: "2244994945", Name: "Twitter Dev", UserName: "TwitterDev"})
+	c := srv.Client()
+	ctx := context.Background()
+
+	reply := func(parentID, text string) string {
+		t.Helper()
+		resp, err := c.PostTweet(ctx, &gotwtr.PostTweetOption{
+			Text:  text,
+			Reply: &gotwtr.TweetReply{InReplyToTweetID: parentID},
+		})
+		if err != nil {
+			t.Fatalf("PostTweet() error = %v", err)
+		}
+		return resp.PostTweetData.ID
+	}
+	root := srv.AddTweet(&gotwtr.Tweet{Text: "root", AuthorID: "2244994945"})
+	r1 := reply(root.ID, "r1")
+	r2 := reply(r1, "r2")
+	r3 := reply(root.ID, "r3")
+	// a reply out of the search window, only reachable through the Tweet replying to it.
+	old := srv.AddTweet(&gotwtr.Tweet{
+		Text:             "old",
+		ConversationID:   "outside the search",
+		ReferencedTweets: []*gotwtr.TweetReferencedTweet{{Type: "replied_to", ID: root.ID}},
+	})
+	r4 := srv.AddTweet(&gotwtr.Tweet{
+		Text:             "r4",
+		ConversationID:   root.ID,
+		ReferencedTweets: []*gotwtr.TweetReferencedTweet{{Type: "replied_to", ID: old.ID}},
+	})
+	// a reply to a deleted Tweet.
+	orphan := srv.AddTweet(&gotwtr.Tweet{
+		Text:             "orphan",
+		ConversationID:   root.ID,
+		ReferencedTweets: []*gotwtr.TweetReferencedTweet{{Type: "replied_to", ID: "1"}},
+	})
+
+	cv, err := c.FetchConversation(ctx, r2, &gotwtr.FetchConversationOption{
+		HiddenReplyIDs: []string{r3},
+	})
+	if err != nil {
+		t.Fatalf("FetchConversation() error = %v", err)
+	}
+	if cv.ID != root.ID || cv.Root == nil || cv.Root.Tweet.ID != root.ID || cv.Len() != 7 {
+		t.Fatalf("FetchConversation() = %+v, want the conversation of %v with 7 tweets", cv, root.ID)
+	}
+	flatten := func(includeHidden bool) []string {
+		var texts []string
+		for _, n := range cv.Flatten(includeHidden) {
+			texts = append(texts, n.Tweet.Text)
+		}
+		return texts
+	}
+	if diff := cmp.Diff([]string{"root", "r1", "r2", "r3", "old", "r4", "orphan"}, flatten(true)); diff != "" {
+		t.Errorf("Conversation.Flatten(true) mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"root", "r1", "r2", "old", "r4", "orphan"}, flatten(false)); diff != "" {
+		t.Errorf("Conversation.Flatten(false) mismatch (-want +got):\n%s", diff)
+	}
+	if n := cv.Node(r2); n.Depth != 2 || n.Parent.Tweet.ID != r1 {
+		t.Errorf("Conversation.Node(%v) = %+v, want depth 2 under %v", r2, n, r1)
+	}
+	if n := cv.Node(r4.ID); n.Depth != 2 || n.Parent.Tweet.ID != old.ID {
+		t.Errorf("Conversation.Node(%v) = %+v, want depth 2 under %v", r4.ID, n, old.ID)
+	}
+	if len(cv.Orphans) != 1 || cv.Orphans[0].Tweet.ID != orphan.ID {
+		t.Errorf("Conversation.Orphans = %+v, want %v", cv.Orphans, orphan.ID)
+	}
+
+	if err := cv.HideReply(ctx, r1, true); err != nil {
+		t.Fatalf("Conversation.HideReply() error = %v", err)
+	}
+	if !srv.Hidden(r1) {
+		t.Errorf("Conversation.HideReply() did not hide %v", r1)
+	}
+	if diff := cmp.Diff([]string{"root", "old", "r4", "orphan"}, flatten(false)); diff != "" {
+		t.Errorf("Conversation.Flatten(false) after HideReply mismatch (-want +got):\n%s", diff)
+	}
+	if err := cv.HideReply(ctx, root.ID, true); err == nil {
+		t.Errorf("Conversation.HideReply() of the root error = nil, want an error")
+	}
+
+	b, err := json.Marshal(cv)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	var nested struct {
+		Root struct {
+			Replies []struct {
+				Tweet struct {
+					Text string `json:"text"`
+				} `json:"tweet"`
+			} `json:"replies"`
+		} `json:"root"`
+	}
+	if err := json.Unmarshal(b, &nested); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if len(nested.Root.Replies) != 3 || nested.Root.Replies[2].Tweet.Text != "old" {
+		t.Errorf("json.Marshal() = %s, want the nested replies of the root", b)
+	}
+}
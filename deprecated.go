@@ -0,0 +1,179 @@
+package gotwtr
+
+// The types below are the former names of the types shared by every endpoint which returns Tweets or users.
+// Their fields are those of the shared types, so the code which handles a Tweet or a User works whichever endpoint it came from.
+
+// LookUpUsersWhoLiked is the former name of User.
+//
+// Deprecated: use User.
+type LookUpUsersWhoLiked = User
+
+// LookUpUsersWhoLikedWithheld is the former name of UserWithheld.
+//
+// Deprecated: use UserWithheld.
+type LookUpUsersWhoLikedWithheld = UserWithheld
+
+// LookUpUsersWhoLikedEntity is the former name of UserEntity.
+//
+// Deprecated: use UserEntity.
+type LookUpUsersWhoLikedEntity = UserEntity
+
+// LookUpUsersWhoLikedURL is the former name of UserURL.
+//
+// Deprecated: use UserURL.
+type LookUpUsersWhoLikedURL = UserURL
+
+// LookUpUsersWhoLikedDescription is the former name of UserDescription.
+//
+// Deprecated: use UserDescription.
+type LookUpUsersWhoLikedDescription = UserDescription
+
+// LookUpUsersWhoLikedURLContent is the former name of UserURLs.
+//
+// Deprecated: use UserURLs.
+type LookUpUsersWhoLikedURLContent = UserURLs
+
+// LookUpUsersWhoLikedHashTag is the former name of UserHashtag.
+//
+// Deprecated: use UserHashtag.
+type LookUpUsersWhoLikedHashTag = UserHashtag
+
+// LookUpUsersWhoLikedMention is the former name of UserMention.
+//
+// Deprecated: use UserMention.
+type LookUpUsersWhoLikedMention = UserMention
+
+// LookUpUsersWhoLikedCashTag is the former name of UserCashtag.
+//
+// Deprecated: use UserCashtag.
+type LookUpUsersWhoLikedCashTag = UserCashtag
+
+// LookUpUsersWhoLikedPublicMetrics is the former name of UserPublicMetrics.
+//
+// Deprecated: use UserPublicMetrics.
+type LookUpUsersWhoLikedPublicMetrics = UserPublicMetrics
+
+// LookUpUsersWhoLikedIncludes is the former name of UserIncludes.
+//
+// Deprecated: use UserIncludes.
+type LookUpUsersWhoLikedIncludes = UserIncludes
+
+// TweetsUserLiked is the former name of Tweet.
+//
+// Deprecated: use Tweet.
+type TweetsUserLiked = Tweet
+
+// TweetsUserLikedReferencedTweets is the former name of TweetReferencedTweet.
+//
+// Deprecated: use TweetReferencedTweet.
+type TweetsUserLikedReferencedTweets = TweetReferencedTweet
+
+// TweetsUserLikedAttachments is the former name of TweetAttachment.
+//
+// Deprecated: use TweetAttachment.
+type TweetsUserLikedAttachments = TweetAttachment
+
+// TweetsUserLikedGeo is the former name of TweetGeo.
+//
+// Deprecated: use TweetGeo.
+type TweetsUserLikedGeo = TweetGeo
+
+// TweetsUserLikedGeoCoordinates is the former name of TweetCoordinates.
+//
+// Deprecated: use TweetCoordinates.
+type TweetsUserLikedGeoCoordinates = TweetCoordinates
+
+// TweetsUserLikedContextAnnotations is the former name of TweetContextAnnotation.
+//
+// Deprecated: use TweetContextAnnotation.
+type TweetsUserLikedContextAnnotations = TweetContextAnnotation
+
+// TweetsUserLikedContextAnnotationsDomain is the former name of TweetContextObj.
+//
+// Deprecated: use TweetContextObj.
+type TweetsUserLikedContextAnnotationsDomain = TweetContextObj
+
+// TweetsUserLikedContextAnnotationsEntity is the former name of TweetContextObj.
+//
+// Deprecated: use TweetContextObj.
+type TweetsUserLikedContextAnnotationsEntity = TweetContextObj
+
+// TweetsUserLikedEntities is the former name of TweetEntity.
+//
+// Deprecated: use TweetEntity.
+type TweetsUserLikedEntities = TweetEntity
+
+// TweetsUserLikedEntitiesAnnotation is the former name of TweetAnnotation.
+//
+// Deprecated: use TweetAnnotation.
+type TweetsUserLikedEntitiesAnnotation = TweetAnnotation
+
+// TweetsUserLikedEntitiesURLContent is the former name of TweetURL.
+//
+// Deprecated: use TweetURL.
+type TweetsUserLikedEntitiesURLContent = TweetURL
+
+// TweetsUserLikedEntitiesHashTag is the former name of TweetHashtag.
+//
+// Deprecated: use TweetHashtag.
+type TweetsUserLikedEntitiesHashTag = TweetHashtag
+
+// TweetsUserLikedEntitiesMention is the former name of TweetMention.
+//
+// Deprecated: use TweetMention.
+type TweetsUserLikedEntitiesMention = TweetMention
+
+// TweetsUserLikedEntitiesCashTag is the former name of TweetCashtag.
+//
+// Deprecated: use TweetCashtag.
+type TweetsUserLikedEntitiesCashTag = TweetCashtag
+
+// TweetsUserLikedWithheld is the former name of TweetWithheld.
+//
+// Deprecated: use TweetWithheld.
+type TweetsUserLikedWithheld = TweetWithheld
+
+// TweetsUserLikedPublicMetrics is the former name of TweetMetrics.
+//
+// Deprecated: use TweetMetrics.
+type TweetsUserLikedPublicMetrics = TweetMetrics
+
+// TweetsUserLikedNonPublicMetrics is the former name of TweetMetrics.
+//
+// Deprecated: use TweetMetrics.
+type TweetsUserLikedNonPublicMetrics = TweetMetrics
+
+// TweetsUserLikedOrganicMetrics is the former name of TweetMetrics.
+//
+// Deprecated: use TweetMetrics.
+type TweetsUserLikedOrganicMetrics = TweetMetrics
+
+// TweetsUserLikedPromotedMetrics is the former name of TweetMetrics.
+//
+// Deprecated: use TweetMetrics.
+type TweetsUserLikedPromotedMetrics = TweetMetrics
+
+// TweetsUserLikedEditControls is the former name of TweetEditControls.
+//
+// Deprecated: use TweetEditControls.
+type TweetsUserLikedEditControls = TweetEditControls
+
+// Me is the former name of User.
+//
+// Deprecated: use User.
+type Me = User
+
+// MeWithheld is the former name of UserWithheld.
+//
+// Deprecated: use UserWithheld.
+type MeWithheld = UserWithheld
+
+// MeIncludes is the former name of UserIncludes.
+//
+// Deprecated: use UserIncludes.
+type MeIncludes = UserIncludes
+
+// LookUpUsersWhoPurchasedSpaceTicketIncludes is the former name of UserIncludes.
+//
+// Deprecated: use UserIncludes.
+type LookUpUsersWhoPurchasedSpaceTicketIncludes = UserIncludes
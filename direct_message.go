@@ -1,5 +1,10 @@
 package gotwtr
 
+import (
+	"bytes"
+	"encoding/json"
+)
+
 type DMEventField string
 
 const (
@@ -23,13 +28,124 @@ const (
 )
 
 type DirectMessage struct {
-	Attachments      []DirectMessageAttachment `json:"attachments,omitempty"`
-	CreatedAt        string                    `json:"created_at"`
-	DMConversationID string                    `json:"dm_conversation_id"`
-	EventType        string                    `json:"event_type"`
-	ID               string                    `json:"id"`
-	SenderID         string                    `json:"sender_id"`
-	Text             string                    `json:"text,omitempty"`
+	Attachments      []DirectMessageAttachment       `json:"attachments,omitempty"`
+	CreatedAt        Time                            `json:"created_at"`
+	DMConversationID string                          `json:"dm_conversation_id"`
+	EventType        string                          `json:"event_type"`
+	ID               string                          `json:"id"`
+	ParticipantIDs   []string                        `json:"participant_ids,omitempty"`
+	ReferencedTweets []*DirectMessageReferencedTweet `json:"referenced_tweets,omitempty"`
+	SenderID         string                          `json:"sender_id"`
+	Text             string                          `json:"text,omitempty"`
+	// MediaKeys holds attachments.media_keys of looked up events.
+	// They can be resolved with DirectMessageIncludes.Media when ExpansionAttachmentsMediaKeys is requested.
+	MediaKeys []string `json:"-"`
+}
+
+// UnmarshalJSON decodes attachments both as sent by the manage endpoints, a list of media IDs,
+// and as returned by the lookup endpoints, an object of media keys.
+func (d *DirectMessage) UnmarshalJSON(b []byte) error {
+	type alias DirectMessage
+	aux := struct {
+		*alias
+		Attachments json.RawMessage `json:"attachments,omitempty"`
+	}{
+		alias: (*alias)(d),
+	}
+	if err := json.Unmarshal(b, &aux); err != nil {
+		return err
+	}
+	raw := bytes.TrimSpace(aux.Attachments)
+	switch {
+	case len(raw) == 0 || bytes.Equal(raw, []byte("null")):
+		// do nothing
+	case raw[0] == '[':
+		return json.Unmarshal(raw, &d.Attachments)
+	default:
+		var attachments struct {
+			MediaKeys []string `json:"media_keys"`
+		}
+		if err := json.Unmarshal(raw, &attachments); err != nil {
+			return err
+		}
+		d.MediaKeys = attachments.MediaKeys
+	}
+	return nil
+}
+
+// MarshalJSON encodes MediaKeys back as the attachments object of the lookup endpoints,
+// so that looked up events can be stored and decoded again without loss.
+func (d DirectMessage) MarshalJSON() ([]byte, error) {
+	type alias DirectMessage
+	out := struct {
+		alias
+		// a message which is sent has no creation time, which is left out rather than encoded as null.
+		CreatedAt   *Time       `json:"created_at,omitempty"`
+		Attachments interface{} `json:"attachments,omitempty"`
+	}{alias: alias(d)}
+	if !d.CreatedAt.IsZero() {
+		out.CreatedAt = &d.CreatedAt
+	}
+	switch {
+	case len(d.Attachments) > 0:
+		out.Attachments = d.Attachments
+	case len(d.MediaKeys) > 0:
+		out.Attachments = struct {
+			MediaKeys []string `json:"media_keys"`
+		}{MediaKeys: d.MediaKeys}
+	}
+	return json.Marshal(out)
+}
+
+// Event returns the typed event of d according to its EventType,
+// or nil if the event type is unknown.
+func (d *DirectMessage) Event() DirectMessageEvent {
+	switch EventTypes(d.EventType) {
+	case EventTypesFieldMessageCreate:
+		return &MessageCreateEvent{DirectMessage: d}
+	case EventTypesFieldParticipantsJoin:
+		return &ParticipantsJoinEvent{DirectMessage: d}
+	case EventTypesFieldParticipantsLeave:
+		return &ParticipantsLeaveEvent{DirectMessage: d}
+	default:
+		return nil
+	}
+}
+
+// DirectMessageEvent is one of *MessageCreateEvent, *ParticipantsJoinEvent or *ParticipantsLeaveEvent.
+type DirectMessageEvent interface {
+	event() *DirectMessage
+}
+
+// MessageCreateEvent is a message sent by SenderID, with Text, MediaKeys and ReferencedTweets.
+type MessageCreateEvent struct {
+	*DirectMessage
+}
+
+func (e *MessageCreateEvent) event() *DirectMessage { return e.DirectMessage }
+
+// ParticipantsJoinEvent is ParticipantIDs joining a group conversation.
+type ParticipantsJoinEvent struct {
+	*DirectMessage
+}
+
+func (e *ParticipantsJoinEvent) event() *DirectMessage { return e.DirectMessage }
+
+// ParticipantsLeaveEvent is ParticipantIDs leaving a group conversation.
+type ParticipantsLeaveEvent struct {
+	*DirectMessage
+}
+
+func (e *ParticipantsLeaveEvent) event() *DirectMessage { return e.DirectMessage }
+
+type DirectMessageReferencedTweet struct {
+	ID string `json:"id"`
+}
+
+type DirectMessageIncludes struct {
+	Media  []*Media `json:"media,omitempty"`
+	Tweets []*Tweet `json:"tweets,omitempty"`
+	Users  []*User  `json:"users,omitempty"`
 }
 
 type DirectMessageMeta struct {
@@ -86,28 +202,31 @@ type PostDMResponse struct {
 }
 
 type LookUpAllOneToOneDMResponse struct {
-	Message []*DirectMessage    `json:"data"`
-	Errors  []*APIResponseError `json:"errors,omitempty"`
-	Meta    *DirectMessageMeta  `json:"meta,omitempty"`
-	Title   string              `json:"title,omitempty"`
-	Detail  string              `json:"detail,omitempty"`
-	Type    string              `json:"type,omitempty"`
+	Message  []*DirectMessage       `json:"data"`
+	Includes *DirectMessageIncludes `json:"includes,omitempty"`
+	Errors   []*APIResponseError    `json:"errors,omitempty"`
+	Meta     *DirectMessageMeta     `json:"meta,omitempty"`
+	Title    string                 `json:"title,omitempty"`
+	Detail   string                 `json:"detail,omitempty"`
+	Type     string                 `json:"type,omitempty"`
 }
 
 type LookUpDMResponse struct {
-	Message []*DirectMessage    `json:"data"`
-	Errors  []*APIResponseError `json:"errors,omitempty"`
-	Meta    *DirectMessageMeta  `json:"meta,omitempty"`
-	Title   string              `json:"title,omitempty"`
-	Detail  string              `json:"detail,omitempty"`
-	Type    string              `json:"type,omitempty"`
+	Message  []*DirectMessage       `json:"data"`
+	Includes *DirectMessageIncludes `json:"includes,omitempty"`
+	Errors   []*APIResponseError    `json:"errors,omitempty"`
+	Meta     *DirectMessageMeta     `json:"meta,omitempty"`
+	Title    string                 `json:"title,omitempty"`
+	Detail   string                 `json:"detail,omitempty"`
+	Type     string                 `json:"type,omitempty"`
 }
 
 type LookUpAllDMResponse struct {
-	Message []*DirectMessage    `json:"data"`
-	Errors  []*APIResponseError `json:"errors,omitempty"`
-	Meta    *DirectMessageMeta  `json:"meta,omitempty"`
-	Title   string              `json:"title,omitempty"`
-	Detail  string              `json:"detail,omitempty"`
-	Type    string              `json:"type,omitempty"`
+	Message  []*DirectMessage       `json:"data"`
+	Includes *DirectMessageIncludes `json:"includes,omitempty"`
+	Errors   []*APIResponseError    `json:"errors,omitempty"`
+	Meta     *DirectMessageMeta     `json:"meta,omitempty"`
+	Title    string                 `json:"title,omitempty"`
+	Detail   string                 `json:"detail,omitempty"`
+	Type     string                 `json:"type,omitempty"`
 }
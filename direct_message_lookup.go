@@ -12,7 +12,7 @@ func lookUpAllOneToOneDM(ctx context.Context, c *client, participantID string, o
 	if participantID == "" {
 		return nil, errors.New("lookup all one to one DM: participant id parameter is required")
 	}
-	ep := fmt.Sprintf(lookUpAllOneToOneDMURL, participantID)
+	ep := c.baseURL + fmt.Sprintf(lookUpAllOneToOneDMURL, participantID)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ep, nil)
 	if err != nil {
@@ -31,7 +31,7 @@ func lookUpAllOneToOneDM(ctx context.Context, c *client, participantID string, o
 	}
 	dmopt.addQuery(req)
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("lookup all one to one DM response: %w", err)
 	}
@@ -42,11 +42,7 @@ func lookUpAllOneToOneDM(ctx context.Context, c *client, participantID string, o
 		return nil, fmt.Errorf("lookup all DM decode: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &lookUpAllOneToOneDM, &HTTPError{
-			APIName: "lookup all one to one DM",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &lookUpAllOneToOneDM, newAPIError("lookup all one to one DM", req, resp)
 	}
 	return &lookUpAllOneToOneDM, nil
 }
@@ -55,7 +51,7 @@ func lookUpDM(ctx context.Context, c *client, dmConversationID string, opt ...*D
 	if dmConversationID == "" {
 		return nil, errors.New("lookup DM: dm conversation id parameter is required")
 	}
-	ep := fmt.Sprintf(lookUpDMURL, dmConversationID)
+	ep := c.baseURL + fmt.Sprintf(lookUpDMURL, dmConversationID)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ep, nil)
 	if err != nil {
@@ -74,7 +70,7 @@ func lookUpDM(ctx context.Context, c *client, dmConversationID string, opt ...*D
 	}
 	dmopt.addQuery(req)
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("lookup DM response: %w", err)
 	}
@@ -85,17 +81,13 @@ func lookUpDM(ctx context.Context, c *client, dmConversationID string, opt ...*D
 		return nil, fmt.Errorf("lookup DM decode: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &lookUpDM, &HTTPError{
-			APIName: "lookup DM",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &lookUpDM, newAPIError("lookup DM", req, resp)
 	}
 	return &lookUpDM, nil
 }
 
 func lookUpAllDM(ctx context.Context, c *client, opt ...*DirectMessageOption) (*LookUpAllDMResponse, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, lookUpAllDMURL, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+lookUpAllDMURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("lookup all DM with ctx: %w", err)
 	}
@@ -112,7 +104,7 @@ func lookUpAllDM(ctx context.Context, c *client, opt ...*DirectMessageOption) (*
 	}
 	dmopt.addQuery(req)
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("lookup all DM response: %w", err)
 	}
@@ -123,11 +115,7 @@ func lookUpAllDM(ctx context.Context, c *client, opt ...*DirectMessageOption) (*
 		return nil, fmt.Errorf("lookup all DM decode: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &lookUpAllDM, &HTTPError{
-			APIName: "lookup all DM",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &lookUpAllDM, newAPIError("lookup all DM", req, resp)
 	}
 	return &lookUpAllDM, nil
 }
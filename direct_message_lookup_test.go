@@ -30,6 +30,9 @@ var lookUpDM []byte
 //go:embed testdata/lookup_dm_option.json
 var lookUpDMOption []byte
 
+//go:embed testdata/lookup_dm_events.json
+var lookUpDMEvents []byte
+
 //go:embed testdata/403.json
 var forbidden []byte
 
@@ -98,7 +101,7 @@ func Test_LookUpAllOneToOneDM(t *testing.T) {
 						Text:             "Another photo https://t.co/J5KotyeIyd",
 						EventType:        "MessageCreate",
 						DMConversationID: "1585094756761149440",
-						CreatedAt:        "2022-10-26T17:24:21.000Z",
+						CreatedAt:        mustParseTime("2022-10-26T17:24:21.000Z"),
 						SenderID:         "906948460078698496",
 					},
 				},
@@ -214,9 +217,84 @@ func Test_LookUpDM(t *testing.T) {
 						Text:             "Another photo https://t.co/J5KotyeIyd",
 						EventType:        "MessageCreate",
 						DMConversationID: "1585094756761149440",
-						CreatedAt:        "2022-10-26T17:24:21.000Z",
+						CreatedAt:        mustParseTime("2022-10-26T17:24:21.000Z"),
+						SenderID:         "906948460078698496",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "200 ok every event type and includes",
+			args: args{
+				ctx: context.Background(),
+				client: mockHTTPClient(func(req *http.Request) *http.Response {
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       io.NopCloser(strings.NewReader(string(lookUpDMEvents))),
+					}
+				}),
+				conversationID: "1585094756761149440",
+				opt: []*gotwtr.DirectMessageOption{
+					{
+						DMEventFields: []gotwtr.DMEventField{
+							gotwtr.DirectMessageFieldAttachments,
+							gotwtr.DirectMessageFieldParticipantIDs,
+							gotwtr.DirectMessageFieldReferencedTweets,
+						},
+						Expansions: []gotwtr.Expansion{
+							gotwtr.ExpansionAttachmentsMediaKeys,
+							gotwtr.ExpansionParticipantIDs,
+							gotwtr.ExpansionReferencedTweetsID,
+							gotwtr.ExpansionSenderID,
+						},
+					},
+				},
+			},
+			want: &gotwtr.LookUpDMResponse{
+				Message: []*gotwtr.DirectMessage{
+					{
+						ID:               "1585321444547837958",
+						Text:             "Have a look https://t.co/J5KotyeIyd",
+						EventType:        "MessageCreate",
+						DMConversationID: "1585094756761149440",
+						CreatedAt:        mustParseTime("2022-10-26T17:25:21.000Z"),
 						SenderID:         "906948460078698496",
+						MediaKeys:        []string{"3_1585321435064315905"},
+						ReferencedTweets: []*gotwtr.DirectMessageReferencedTweet{
+							{ID: "1578900353814519810"},
+						},
+					},
+					{
+						ID:               "1585321444547837957",
+						EventType:        "ParticipantsLeave",
+						DMConversationID: "1585094756761149440",
+						CreatedAt:        mustParseTime("2022-10-26T17:24:51.000Z"),
+						ParticipantIDs:   []string{"1347377410539327488"},
 					},
+					{
+						ID:               "1585321444547837956",
+						EventType:        "ParticipantsJoin",
+						DMConversationID: "1585094756761149440",
+						CreatedAt:        mustParseTime("2022-10-26T17:24:21.000Z"),
+						SenderID:         "906948460078698496",
+						ParticipantIDs:   []string{"906948460078698496", "1347377410539327488"},
+					},
+				},
+				Includes: &gotwtr.DirectMessageIncludes{
+					Media: []*gotwtr.Media{
+						{MediaKey: "3_1585321435064315905", Type: "photo"},
+					},
+					Tweets: []*gotwtr.Tweet{
+						{ID: "1578900353814519810", Text: "Hello world"},
+					},
+					Users: []*gotwtr.User{
+						{ID: "906948460078698496", Name: "Sender", UserName: "sender"},
+						{ID: "1347377410539327488", Name: "Participant", UserName: "participant"},
+					},
+				},
+				Meta: &gotwtr.DirectMessageMeta{
+					ResultCount: 3,
 				},
 			},
 			wantErr: false,
@@ -329,7 +407,7 @@ func Test_LookUpAllDM(t *testing.T) {
 						Text:             "Another photo https://t.co/J5KotyeIyd",
 						EventType:        "MessageCreate",
 						DMConversationID: "1585094756761149440",
-						CreatedAt:        "2022-10-26T17:24:21.000Z",
+						CreatedAt:        mustParseTime("2022-10-26T17:24:21.000Z"),
 						SenderID:         "906948460078698496",
 					},
 				},
@@ -0,0 +1,112 @@
+package gotwtr
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/sivchari/gotwtr/snowflake"
+)
+
+// DirectMessageConversation is the events of one conversation, oldest first.
+type DirectMessageConversation struct {
+	ID     string
+	Events []*DirectMessage
+}
+
+// GroupDirectMessages rebuilds conversations from events as returned by the lookup endpoints and pagers.
+// Events are grouped by DMConversationID, deduplicated by ID and ordered oldest first,
+// and conversations are ordered by their latest event, most recent first.
+func GroupDirectMessages(events []*DirectMessage) []*DirectMessageConversation {
+	var (
+		convs []*DirectMessageConversation
+		byID  = make(map[string]*DirectMessageConversation)
+		seen  = make(map[string]bool, len(events))
+	)
+	for _, dm := range events {
+		if dm == nil || seen[dm.ID] {
+			continue
+		}
+		seen[dm.ID] = true
+		conv, ok := byID[dm.DMConversationID]
+		if !ok {
+			conv = &DirectMessageConversation{ID: dm.DMConversationID}
+			byID[dm.DMConversationID] = conv
+			convs = append(convs, conv)
+		}
+		conv.Events = append(conv.Events, dm)
+	}
+	for _, conv := range convs {
+		sort.SliceStable(conv.Events, func(i, j int) bool {
+			return snowflake.Newer(conv.Events[j].ID, conv.Events[i].ID)
+		})
+	}
+	sort.SliceStable(convs, func(i, j int) bool {
+		return snowflake.Newer(convs[i].latest().ID, convs[j].latest().ID)
+	})
+	return convs
+}
+
+func (c *DirectMessageConversation) latest() *DirectMessage {
+	return c.Events[len(c.Events)-1]
+}
+
+// Messages returns the MessageCreate events of the conversation, oldest first.
+func (c *DirectMessageConversation) Messages() []*MessageCreateEvent {
+	var msgs []*MessageCreateEvent
+	for _, dm := range c.Events {
+		if e, ok := dm.Event().(*MessageCreateEvent); ok {
+			msgs = append(msgs, e)
+		}
+	}
+	return msgs
+}
+
+// Participants replays the events of the conversation and returns the IDs of the users in it after the last one,
+// in order of appearance. One-to-one conversations start with both users of their ID,
+// and senders are counted as participants even if their join event is not among the events.
+func (c *DirectMessageConversation) Participants() []string {
+	var ids []string
+	add := func(id string) {
+		if id != "" && !containsID(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	if users := strings.Split(c.ID, "-"); len(users) == 2 {
+		add(users[0])
+		add(users[1])
+	}
+	for _, dm := range c.Events {
+		switch e := dm.Event().(type) {
+		case *MessageCreateEvent:
+			add(e.SenderID)
+		case *ParticipantsJoinEvent:
+			for _, id := range e.ParticipantIDs {
+				add(id)
+			}
+		case *ParticipantsLeaveEvent:
+			for _, id := range e.ParticipantIDs {
+				ids = removeID(ids, id)
+			}
+		}
+	}
+	return ids
+}
+
+func containsID(ss []string, v string) bool {
+	for _, s := range ss {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
+
+func removeID(ss []string, v string) []string {
+	out := ss[:0]
+	for _, s := range ss {
+		if s != v {
+			out = append(out, s)
+		}
+	}
+	return out
+}
@@ -0,0 +1,111 @@
+package gotwtr_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sivchari/gotwtr"
+)
+
+func Test_DirectMessageEvent(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		eventType string
+		want      gotwtr.DirectMessageEvent
+	}{
+		{
+			name:      "message create",
+			eventType: "MessageCreate",
+			want:      &gotwtr.MessageCreateEvent{},
+		},
+		{
+			name:      "participants join",
+			eventType: "ParticipantsJoin",
+			want:      &gotwtr.ParticipantsJoinEvent{},
+		},
+		{
+			name:      "participants leave",
+			eventType: "ParticipantsLeave",
+			want:      &gotwtr.ParticipantsLeaveEvent{},
+		},
+		{
+			name:      "unknown",
+			eventType: "Unknown",
+			want:      nil,
+		},
+	}
+	for i, tt := range tests {
+		i := i
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			dm := &gotwtr.DirectMessage{ID: "1", EventType: tt.eventType}
+			var got gotwtr.DirectMessageEvent
+			switch e := dm.Event().(type) {
+			case *gotwtr.MessageCreateEvent:
+				if e.DirectMessage != dm {
+					t.Errorf("DirectMessage.Event() index = %v does not wrap the message", i)
+				}
+				got = &gotwtr.MessageCreateEvent{}
+			case *gotwtr.ParticipantsJoinEvent:
+				if e.DirectMessage != dm {
+					t.Errorf("DirectMessage.Event() index = %v does not wrap the message", i)
+				}
+				got = &gotwtr.ParticipantsJoinEvent{}
+			case *gotwtr.ParticipantsLeaveEvent:
+				if e.DirectMessage != dm {
+					t.Errorf("DirectMessage.Event() index = %v does not wrap the message", i)
+				}
+				got = &gotwtr.ParticipantsLeaveEvent{}
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("DirectMessage.Event() index = %v mismatch (-want +got):\n%s", i, diff)
+			}
+		})
+	}
+}
+
+func Test_GroupDirectMessages(t *testing.T) {
+	t.Parallel()
+	events := []*gotwtr.DirectMessage{
+		{ID: "105", EventType: "MessageCreate", DMConversationID: "10", SenderID: "2", Text: "bye"},
+		{ID: "104", EventType: "ParticipantsLeave", DMConversationID: "10", ParticipantIDs: []string{"3"}},
+		{ID: "99", EventType: "MessageCreate", DMConversationID: "1-2", SenderID: "1", Text: "hi"},
+		{ID: "102", EventType: "MessageCreate", DMConversationID: "10", SenderID: "3", Text: "hello"},
+		{ID: "101", EventType: "ParticipantsJoin", DMConversationID: "10", SenderID: "1", ParticipantIDs: []string{"1", "2", "3"}},
+		// duplicated by an overlapping page
+		{ID: "102", EventType: "MessageCreate", DMConversationID: "10", SenderID: "3", Text: "hello"},
+	}
+
+	convs := gotwtr.GroupDirectMessages(events)
+	var ids []string
+	for _, conv := range convs {
+		ids = append(ids, conv.ID)
+	}
+	if diff := cmp.Diff([]string{"10", "1-2"}, ids); diff != "" {
+		t.Fatalf("GroupDirectMessages() conversations mismatch (-want +got):\n%s", diff)
+	}
+
+	group := convs[0]
+	var order []string
+	for _, dm := range group.Events {
+		order = append(order, dm.ID)
+	}
+	if diff := cmp.Diff([]string{"101", "102", "104", "105"}, order); diff != "" {
+		t.Errorf("DirectMessageConversation.Events mismatch (-want +got):\n%s", diff)
+	}
+	var texts []string
+	for _, msg := range group.Messages() {
+		texts = append(texts, msg.Text)
+	}
+	if diff := cmp.Diff([]string{"hello", "bye"}, texts); diff != "" {
+		t.Errorf("DirectMessageConversation.Messages() mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"1", "2"}, group.Participants()); diff != "" {
+		t.Errorf("DirectMessageConversation.Participants() mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"1", "2"}, convs[1].Participants()); diff != "" {
+		t.Errorf("DirectMessageConversation.Participants() one-to-one mismatch (-want +got):\n%s", diff)
+	}
+}
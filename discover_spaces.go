@@ -16,7 +16,7 @@ func discoverSpaces(ctx context.Context, c *client, userIDs []string, opt ...*Di
 		return nil, errors.New("discover spaces: ids parameter must be less than or equal to 100")
 	default:
 	}
-	ep := discoverSpacesURL
+	ep := c.baseURL + discoverSpacesURL
 	for i, uid := range userIDs {
 		if i+1 < len(userIDs) {
 			ep += fmt.Sprintf("%s,", uid)
@@ -42,7 +42,7 @@ func discoverSpaces(ctx context.Context, c *client, userIDs []string, opt ...*Di
 	}
 	dopt.addQuery(req)
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("discover spaces: %w", err)
 	}
@@ -55,11 +55,7 @@ func discoverSpaces(ctx context.Context, c *client, userIDs []string, opt ...*Di
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return &dsr, &HTTPError{
-			APIName: "discover spaces",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &dsr, newAPIError("discover spaces", req, resp)
 	}
 
 	return &dsr, nil
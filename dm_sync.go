@@ -0,0 +1,264 @@
+package gotwtr
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sivchari/gotwtr/snowflake"
+)
+
+// DMCheckpoint records how far a conversation has been synced.
+type DMCheckpoint struct {
+	ConversationID string `json:"conversation_id"`
+	// LastEventID is the ID of the newest event handled. Events up to it are not emitted again.
+	LastEventID string    `json:"last_event_id,omitempty"`
+	SyncedAt    time.Time `json:"synced_at"`
+}
+
+// DMCheckpointStore persists the checkpoints of a DMSyncer, so that a restarted worker resumes where it stopped.
+type DMCheckpointStore interface {
+	// Load returns the checkpoint of the conversation, or nil if it was never synced.
+	Load(ctx context.Context, conversationID string) (*DMCheckpoint, error)
+	Save(ctx context.Context, checkpoint *DMCheckpoint) error
+}
+
+// MemoryDMCheckpointStore is a DMCheckpointStore which keeps the checkpoints in memory.
+type MemoryDMCheckpointStore struct {
+	mu          sync.Mutex
+	checkpoints map[string]DMCheckpoint
+}
+
+// NewMemoryDMCheckpointStore returns an empty MemoryDMCheckpointStore.
+func NewMemoryDMCheckpointStore() *MemoryDMCheckpointStore {
+	return &MemoryDMCheckpointStore{checkpoints: make(map[string]DMCheckpoint)}
+}
+
+func (s *MemoryDMCheckpointStore) Load(ctx context.Context, conversationID string) (*DMCheckpoint, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cp, ok := s.checkpoints[conversationID]
+	if !ok {
+		return nil, nil
+	}
+	return &cp, nil
+}
+
+func (s *MemoryDMCheckpointStore) Save(ctx context.Context, checkpoint *DMCheckpoint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.checkpoints[checkpoint.ConversationID] = *checkpoint
+	return nil
+}
+
+// FileDMCheckpointStore is a DMCheckpointStore which keeps the checkpoints of every conversation in a JSON file.
+// The file is replaced atomically on every save.
+type FileDMCheckpointStore struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewFileDMCheckpointStore returns a FileDMCheckpointStore backed by path, which is created on the first save.
+func NewFileDMCheckpointStore(path string) *FileDMCheckpointStore {
+	return &FileDMCheckpointStore{path: path}
+}
+
+func (s *FileDMCheckpointStore) Load(ctx context.Context, conversationID string) (*DMCheckpoint, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cps, err := s.read()
+	if err != nil {
+		return nil, err
+	}
+	cp, ok := cps[conversationID]
+	if !ok {
+		return nil, nil
+	}
+	return cp, nil
+}
+
+func (s *FileDMCheckpointStore) Save(ctx context.Context, checkpoint *DMCheckpoint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cps, err := s.read()
+	if err != nil {
+		return err
+	}
+	cp := *checkpoint
+	cps[cp.ConversationID] = &cp
+	b, err := json.MarshalIndent(cps, "", "  ")
+	if err != nil {
+		return fmt.Errorf("dm checkpoint store: %w", err)
+	}
+	f, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*")
+	if err != nil {
+		return fmt.Errorf("dm checkpoint store: %w", err)
+	}
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return fmt.Errorf("dm checkpoint store: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return fmt.Errorf("dm checkpoint store: %w", err)
+	}
+	if err := os.Rename(f.Name(), s.path); err != nil {
+		os.Remove(f.Name())
+		return fmt.Errorf("dm checkpoint store: %w", err)
+	}
+	return nil
+}
+
+func (s *FileDMCheckpointStore) read() (map[string]*DMCheckpoint, error) {
+	cps := make(map[string]*DMCheckpoint)
+	b, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return cps, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("dm checkpoint store: %w", err)
+	}
+	if err := json.Unmarshal(b, &cps); err != nil {
+		return nil, fmt.Errorf("dm checkpoint store decode: %w", err)
+	}
+	return cps, nil
+}
+
+// DMHandler receives the events emitted by a DMSyncer with the includes of the sync which fetched them.
+// When it returns an error the sync stops, and the event is emitted again by the next sync.
+type DMHandler func(ctx context.Context, dm *DirectMessage, includes *DirectMessageIncludes) error
+
+// DMWriterHandler returns a DMHandler which writes every event to w as a line of JSON.
+func DMWriterHandler(w io.Writer) DMHandler {
+	var mu sync.Mutex
+	enc := json.NewEncoder(w)
+	return func(ctx context.Context, dm *DirectMessage, includes *DirectMessageIncludes) error {
+		mu.Lock()
+		defer mu.Unlock()
+		return enc.Encode(dm)
+	}
+}
+
+// DMSyncer archives Direct Message conversations incrementally. Every sync walks the pages of a conversation,
+// newest first, until it reaches the checkpoint of the previous sync, then emits the new events oldest first
+// and moves the checkpoint forward. Events are emitted at least once: a sync interrupted by a failure
+// emits the events after the last handled one again.
+//
+//	s := client.DMSyncer(gotwtr.NewFileDMCheckpointStore("dm.json"), gotwtr.DMWriterHandler(f))
+//	for range time.Tick(time.Minute) {
+//		n, err := s.Sync(ctx, conversationIDs...)
+//		...
+//	}
+type DMSyncer struct {
+	c       *Client
+	store   DMCheckpointStore
+	handler DMHandler
+	opt     []*DirectMessageOption
+
+	mu sync.Mutex
+}
+
+// DMSyncer returns a DMSyncer which keeps its checkpoints in store and emits events to handler.
+// opt is sent with every request, e.g. for expansions; its pagination token is ignored.
+func (c *Client) DMSyncer(store DMCheckpointStore, handler DMHandler, opt ...*DirectMessageOption) *DMSyncer {
+	return &DMSyncer{
+		c:       c,
+		store:   store,
+		handler: handler,
+		opt:     opt,
+	}
+}
+
+// Sync emits the events of the conversations which are newer than their checkpoints and returns how many were emitted.
+// It stops at the first conversation which fails.
+func (s *DMSyncer) Sync(ctx context.Context, conversationIDs ...string) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var o DirectMessageOption
+	switch len(s.opt) {
+	case 0:
+		// do nothing
+	case 1:
+		o = *s.opt[0]
+	default:
+		return 0, errors.New("dm sync: only one option is allowed")
+	}
+	o.PaginationToken = ""
+
+	var total int
+	for _, id := range conversationIDs {
+		n, err := s.syncConversation(ctx, id, &o)
+		total += n
+		if err != nil {
+			return total, fmt.Errorf("dm sync %v: %w", id, err)
+		}
+	}
+	return total, nil
+}
+
+func (s *DMSyncer) syncConversation(ctx context.Context, conversationID string, opt *DirectMessageOption) (int, error) {
+	if conversationID == "" {
+		return 0, errors.New("conversation id parameter is required")
+	}
+	cp, err := s.store.Load(ctx, conversationID)
+	if err != nil {
+		return 0, fmt.Errorf("load checkpoint: %w", err)
+	}
+	if cp == nil {
+		cp = &DMCheckpoint{ConversationID: conversationID}
+	}
+
+	var (
+		events []*DirectMessage
+		seen   = make(map[string]bool)
+	)
+	pager := s.c.LookUpDMPager(ctx, conversationID, opt)
+walk:
+	for pager.Next() {
+		for _, dm := range pager.Page() {
+			if !snowflake.Newer(dm.ID, cp.LastEventID) {
+				break walk
+			}
+			// new events shift the pages while they are walked, which repeats events across pages.
+			if seen[dm.ID] {
+				continue
+			}
+			seen[dm.ID] = true
+			events = append(events, dm)
+		}
+	}
+	if err := pager.Err(); err != nil {
+		return 0, err
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return snowflake.Newer(events[j].ID, events[i].ID)
+	})
+
+	var n int
+	for _, dm := range events {
+		if err := s.handler(ctx, dm, pager.Includes()); err != nil {
+			if n > 0 {
+				if serr := s.store.Save(ctx, cp); serr != nil {
+					return n, fmt.Errorf("save checkpoint: %w", serr)
+				}
+			}
+			return n, err
+		}
+		cp.LastEventID = dm.ID
+		n++
+	}
+	cp.SyncedAt = time.Now()
+	if err := s.store.Save(ctx, cp); err != nil {
+		return n, fmt.Errorf("save checkpoint: %w", err)
+	}
+	return n, nil
+}
@@ -0,0 +1,146 @@
+package gotwtr_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sivchari/gotwtr"
+	"github.com/sivchari/gotwtr/gotwtrtest"
+)
+
+func Test_DMSyncer(t *testing.T) {
+	t.Parallel()
+	srv := gotwtrtest.NewServer()
+	defer srv.Close()
+	srv.SetMe(&gotwtr.User{ID: "2244994945", Name: "Twitter Dev", UserName: "TwitterDev"})
+	srv.AddUser(&gotwtr.User{ID: "6253282", Name: "Twitter API", UserName: "TwitterAPI"})
+	c := srv.Client()
+	ctx := context.Background()
+
+	send := func(texts ...string) string {
+		t.Helper()
+		var cid string
+		for _, text := range texts {
+			created, err := c.CreateOneToOneDM(ctx, "6253282", &gotwtr.CreateOneToOneDMBody{Text: text})
+			if err != nil {
+				t.Fatalf("CreateOneToOneDM() error = %v", err)
+			}
+			cid = created.DMConversationID
+		}
+		return cid
+	}
+	read := func(buf *bytes.Buffer) []string {
+		t.Helper()
+		var texts []string
+		sc := bufio.NewScanner(buf)
+		for sc.Scan() {
+			var dm gotwtr.DirectMessage
+			if err := json.Unmarshal(sc.Bytes(), &dm); err != nil {
+				t.Fatalf("decode event: %v", err)
+			}
+			texts = append(texts, dm.Text)
+		}
+		buf.Reset()
+		return texts
+	}
+
+	cid := send("one", "two", "three")
+	store := gotwtr.NewFileDMCheckpointStore(filepath.Join(t.TempDir(), "checkpoints.json"))
+	var buf bytes.Buffer
+	opt := &gotwtr.DirectMessageOption{MaxResults: 2}
+	s := c.DMSyncer(store, gotwtr.DMWriterHandler(&buf), opt)
+
+	n, err := s.Sync(ctx, cid)
+	if err != nil {
+		t.Fatalf("DMSyncer.Sync() error = %v", err)
+	}
+	if diff := cmp.Diff([]string{"one", "two", "three"}, read(&buf)); diff != "" || n != 3 {
+		t.Errorf("DMSyncer.Sync() first n = %d mismatch (-want +got):\n%s", n, diff)
+	}
+
+	send("four", "five")
+	if n, err = s.Sync(ctx, cid); err != nil {
+		t.Fatalf("DMSyncer.Sync() error = %v", err)
+	}
+	if diff := cmp.Diff([]string{"four", "five"}, read(&buf)); diff != "" || n != 2 {
+		t.Errorf("DMSyncer.Sync() second n = %d mismatch (-want +got):\n%s", n, diff)
+	}
+
+	// a restarted worker resumes from the stored checkpoint.
+	send("six")
+	restarted := c.DMSyncer(store, gotwtr.DMWriterHandler(&buf), opt)
+	if n, err = restarted.Sync(ctx, cid); err != nil {
+		t.Fatalf("DMSyncer.Sync() error = %v", err)
+	}
+	if diff := cmp.Diff([]string{"six"}, read(&buf)); diff != "" || n != 1 {
+		t.Errorf("DMSyncer.Sync() restarted n = %d mismatch (-want +got):\n%s", n, diff)
+	}
+	cp, err := store.Load(ctx, cid)
+	if err != nil || cp == nil || cp.SyncedAt.IsZero() {
+		t.Errorf("FileDMCheckpointStore.Load() = %+v, %v", cp, err)
+	}
+}
+
+func Test_DMSyncerHandlerError(t *testing.T) {
+	t.Parallel()
+	srv := gotwtrtest.NewServer()
+	defer srv.Close()
+	srv.SetMe(&gotwtr.User{ID: "2244994945", Name: "Twitter Dev", UserName: "TwitterDev"})
+	srv.AddUser(&gotwtr.User{ID: "6253282", Name: "Twitter API", UserName: "TwitterAPI"})
+	c := srv.Client()
+	ctx := context.Background()
+
+	var cid string
+	for _, text := range []string{"one", "two", "three"} {
+		created, err := c.CreateOneToOneDM(ctx, "6253282", &gotwtr.CreateOneToOneDMBody{Text: text})
+		if err != nil {
+			t.Fatalf("CreateOneToOneDM() error = %v", err)
+		}
+		cid = created.DMConversationID
+	}
+
+	errHandler := errors.New("handler failed")
+	var texts []string
+	fail := true
+	s := c.DMSyncer(gotwtr.NewMemoryDMCheckpointStore(), func(ctx context.Context, dm *gotwtr.DirectMessage, includes *gotwtr.DirectMessageIncludes) error {
+		if fail && dm.Text == "two" {
+			return errHandler
+		}
+		texts = append(texts, dm.Text)
+		return nil
+	})
+
+	n, err := s.Sync(ctx, cid)
+	if !errors.Is(err, errHandler) || n != 1 {
+		t.Fatalf("DMSyncer.Sync() = %d, %v, want 1, %v", n, err, errHandler)
+	}
+	fail = false
+	if n, err = s.Sync(ctx, cid); err != nil || n != 2 {
+		t.Fatalf("DMSyncer.Sync() = %d, %v, want 2, nil", n, err)
+	}
+	if diff := cmp.Diff([]string{"one", "two", "three"}, texts); diff != "" {
+		t.Errorf("DMSyncer.Sync() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func Test_DirectMessageMarshalJSON(t *testing.T) {
+	t.Parallel()
+	want := &gotwtr.DirectMessage{ID: "1", EventType: "MessageCreate", MediaKeys: []string{"3_1"}}
+	b, err := json.Marshal(want)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	var got gotwtr.DirectMessage
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if diff := cmp.Diff(want, &got); diff != "" {
+		t.Errorf("DirectMessage round trip mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -1,13 +1,165 @@
 package gotwtr
 
-type HTTPError struct {
-	APIName string
-	Status  string
-	URL     string
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// maxErrorBodySize bounds how much of a failed response is kept to read its problem details.
+	maxErrorBodySize = 1 << 20
+	requestIDHeader  = "x-transaction-id"
+)
+
+// Kinds of APIError which can be matched with errors.Is.
+var (
+	ErrUnauthorized     = errors.New("unauthorized")
+	ErrForbidden        = errors.New("forbidden")
+	ErrNotFound         = errors.New("not found")
+	ErrRateLimited      = errors.New("rate limited")
+	ErrDuplicateContent = errors.New("duplicate content")
+)
+
+// APIError is returned when Twitter answers with an unexpected status.
+// It carries the problem details of the response body, see https://developer.twitter.com/en/support/twitter-api/error-troubleshooting.
+type APIError struct {
+	APIName    string
+	Status     string
+	StatusCode int
+	URL        string
+	// Type is the URI which identifies the kind of problem, e.g. https://api.twitter.com/2/problems/resource-not-found.
+	Type   string
+	Title  string
+	Detail string
+	// Errors holds the individual problems of the response, if any.
+	Errors []*APIResponseError
+	// RateLimitReset is when the rate limit window of the endpoint resets. It is zero if the response did not report it.
+	RateLimitReset time.Time
+	// RequestID identifies the request on Twitter's side and is useful when reporting issues.
+	RequestID string
+}
+
+// HTTPError is the former name of APIError.
+//
+// Deprecated: use APIError.
+type HTTPError = APIError
+
+func (e *APIError) Error() string {
+	msg := e.APIName + ": " + e.Status + " " + e.URL
+	switch {
+	case e.Detail != "":
+		msg += ": " + e.Detail
+	case e.Title != "":
+		msg += ": " + e.Title
+	}
+	return msg
+}
+
+// Is reports whether e is of the kind target, one of ErrUnauthorized, ErrForbidden, ErrNotFound, ErrRateLimited or ErrDuplicateContent.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound || e.hasProblem("resource-not-found")
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrDuplicateContent:
+		return strings.Contains(strings.ToLower(e.Detail), "duplicate content") || e.hasProblem("duplicate-rules")
+	}
+	return false
+}
+
+// hasProblem reports whether e or one of its errors has a problem type ending with kind.
+func (e *APIError) hasProblem(kind string) bool {
+	if strings.HasSuffix(e.Type, "/"+kind) {
+		return true
+	}
+	for _, err := range e.Errors {
+		if err != nil && strings.HasSuffix(err.Type, "/"+kind) {
+			return true
+		}
+	}
+	return false
+}
+
+// errorBody is the body of a failed response. It is read in advance so the problem details can be decoded
+// by newAPIError after the endpoint has decoded its own response.
+type errorBody struct {
+	*bytes.Reader
+	data []byte
+}
+
+func (b *errorBody) Close() error {
+	return nil
+}
+
+// bufferErrorBody replaces the body of a failed response with an errorBody.
+func bufferErrorBody(resp *http.Response) error {
+	if resp.StatusCode < http.StatusBadRequest || resp.Body == nil {
+		return nil
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+	if err != nil {
+		return err
+	}
+	body := &errorBody{Reader: bytes.NewReader(data), data: data}
+	if !json.Valid(data) {
+		// bodies which are not JSON, such as error pages of proxies, are presented to the endpoint as an empty object
+		// so that it reports an APIError instead of a decode error.
+		body.Reader = bytes.NewReader([]byte("{}"))
+	}
+	resp.Body = body
+	return nil
 }
 
-func (e *HTTPError) Error() string {
-	return e.APIName + ": " + e.Status + " " + e.URL
+// newAPIError builds the APIError of the failed call apiName.
+func newAPIError(apiName string, req *http.Request, resp *http.Response) *APIError {
+	e := &APIError{
+		APIName:    apiName,
+		Status:     resp.Status,
+		StatusCode: resp.StatusCode,
+		URL:        req.URL.String(),
+		RequestID:  resp.Header.Get(requestIDHeader),
+	}
+	if reset, err := strconv.ParseInt(resp.Header.Get(rateLimitResetHeader), 10, 64); err == nil {
+		e.RateLimitReset = time.Unix(reset, 0)
+	}
+	if body, ok := resp.Body.(*errorBody); ok {
+		var problem struct {
+			Type   string              `json:"type"`
+			Title  string              `json:"title"`
+			Detail string              `json:"detail"`
+			Errors []*APIResponseError `json:"errors"`
+			// OAuth 2.0 endpoints report errors as defined by RFC 6749.
+			Error            string `json:"error"`
+			ErrorDescription string `json:"error_description"`
+		}
+		if err := json.Unmarshal(body.data, &problem); err == nil {
+			e.Type = problem.Type
+			e.Title = problem.Title
+			e.Detail = problem.Detail
+			e.Errors = problem.Errors
+			if e.Title == "" {
+				e.Title = problem.Error
+			}
+			if e.Detail == "" {
+				e.Detail = problem.ErrorDescription
+			}
+		} else {
+			e.Detail = strings.TrimSpace(string(body.data))
+		}
+	}
+	return e
 }
 
 type APIResponseError struct {
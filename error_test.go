@@ -0,0 +1,161 @@
+package gotwtr_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sivchari/gotwtr"
+)
+
+func Test_APIError(t *testing.T) {
+	t.Parallel()
+	reset := time.Unix(time.Now().Add(15*time.Minute).Unix(), 0)
+	tests := []struct {
+		name     string
+		status   int
+		header   map[string]string
+		body     string
+		call     func(c *gotwtr.Client) error
+		wantKind error
+		want     *gotwtr.APIError
+	}{
+		{
+			name:   "not found",
+			status: http.StatusNotFound,
+			header: map[string]string{"x-transaction-id": "abc123"},
+			body: `{
+				"errors": [{"value": "1", "detail": "Could not find user with id: [1].", "title": "Not Found Error", "resource_type": "user", "parameter": "id", "resource_id": "1", "type": "https://api.twitter.com/2/problems/resource-not-found"}],
+				"title": "Not Found Error",
+				"detail": "Could not find user with id: [1].",
+				"type": "https://api.twitter.com/2/problems/resource-not-found"
+			}`,
+			call: func(c *gotwtr.Client) error {
+				_, err := c.RetrieveSingleUserWithID(context.Background(), "1")
+				return err
+			},
+			wantKind: gotwtr.ErrNotFound,
+			want: &gotwtr.APIError{
+				APIName:    "retrieve single user with id",
+				Status:     "Not Found",
+				StatusCode: http.StatusNotFound,
+				URL:        "https://api.twitter.com/2/users/1",
+				Type:       "https://api.twitter.com/2/problems/resource-not-found",
+				Title:      "Not Found Error",
+				Detail:     "Could not find user with id: [1].",
+				Errors: []*gotwtr.APIResponseError{
+					{
+						Value:        "1",
+						Detail:       "Could not find user with id: [1].",
+						Title:        "Not Found Error",
+						ResourceType: "user",
+						Parameter:    "id",
+						ResourceID:   "1",
+						Type:         "https://api.twitter.com/2/problems/resource-not-found",
+					},
+				},
+				RequestID: "abc123",
+			},
+		},
+		{
+			name:   "unauthorized",
+			status: http.StatusUnauthorized,
+			body:   `{"title": "Unauthorized", "type": "about:blank", "status": 401, "detail": "Unauthorized"}`,
+			call: func(c *gotwtr.Client) error {
+				_, err := c.Followers(context.Background(), "2244994945")
+				return err
+			},
+			wantKind: gotwtr.ErrUnauthorized,
+		},
+		{
+			name:   "duplicate content",
+			status: http.StatusForbidden,
+			body:   `{"detail": "You are not allowed to create a Tweet with duplicate content.", "type": "about:blank", "title": "Forbidden", "status": 403}`,
+			call: func(c *gotwtr.Client) error {
+				_, err := c.PostTweet(context.Background(), &gotwtr.PostTweetOption{Text: "Hello World"})
+				return err
+			},
+			wantKind: gotwtr.ErrDuplicateContent,
+		},
+		{
+			name:   "rate limited",
+			status: http.StatusTooManyRequests,
+			header: map[string]string{"x-rate-limit-reset": strconv.FormatInt(reset.Unix(), 10)},
+			body:   `{"title": "Too Many Requests", "detail": "Too Many Requests", "type": "about:blank", "status": 429}`,
+			call: func(c *gotwtr.Client) error {
+				_, err := c.SearchRecentTweets(context.Background(), "from:TwitterDev")
+				return err
+			},
+			wantKind: gotwtr.ErrRateLimited,
+		},
+		{
+			name:   "body which is not json",
+			status: http.StatusBadGateway,
+			body:   "<html>Bad Gateway</html>\n",
+			call: func(c *gotwtr.Client) error {
+				_, err := c.LookUpList(context.Background(), "84839422")
+				return err
+			},
+			want: &gotwtr.APIError{
+				APIName:    "look up list",
+				Status:     "Bad Gateway",
+				StatusCode: http.StatusBadGateway,
+				URL:        "https://api.twitter.com/2/lists/84839422",
+				Detail:     "<html>Bad Gateway</html>",
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c := gotwtr.New("key", gotwtr.WithHTTPClient(mockHTTPClient(func(req *http.Request) *http.Response {
+				h := http.Header{}
+				for k, v := range tt.header {
+					h.Set(k, v)
+				}
+				return &http.Response{
+					StatusCode: tt.status,
+					Status:     http.StatusText(tt.status),
+					Header:     h,
+					Body:       io.NopCloser(strings.NewReader(tt.body)),
+				}
+			})))
+			err := tt.call(c)
+			var apiErr *gotwtr.APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("error = %v, want *gotwtr.APIError", err)
+			}
+			if apiErr.StatusCode != tt.status {
+				t.Errorf("APIError.StatusCode = %d, want %d", apiErr.StatusCode, tt.status)
+			}
+			if tt.wantKind != nil && !errors.Is(err, tt.wantKind) {
+				t.Errorf("errors.Is(%v, %v) = false, want true", err, tt.wantKind)
+			}
+			if tt.status == http.StatusTooManyRequests && !apiErr.RateLimitReset.Equal(reset) {
+				t.Errorf("APIError.RateLimitReset = %v, want %v", apiErr.RateLimitReset, reset)
+			}
+			if tt.want == nil {
+				return
+			}
+			if diff := cmp.Diff(tt.want, apiErr); diff != "" {
+				t.Errorf("APIError mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_RateLimitErrorIsRateLimited(t *testing.T) {
+	t.Parallel()
+	var err error = &gotwtr.RateLimitError{Endpoint: "GET /2/users/:id/followers", Reset: time.Now()}
+	if !errors.Is(err, gotwtr.ErrRateLimited) {
+		t.Errorf("errors.Is(%v, ErrRateLimited) = false, want true", err)
+	}
+}
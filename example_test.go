@@ -691,7 +691,7 @@ func ExampleClient_ComplianceJobs() {
 
 func ExampleClient_ComplianceJob() {
 	client := gotwtr.New("key")
-	cj, err := client.ComplianceJob(context.Background(), 1382081613278814209)
+	cj, err := client.ComplianceJob(context.Background(), "1382081613278814209")
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -1,9 +1,21 @@
 package gotwtr
 
+import "net/http"
+
 func (c *client) ExportClient() map[string]string {
 	return map[string]string{
-		"bearerToken":    c.bearerToken,
+		"bearerToken":    c.currentBearerToken(),
 		"consumerKey":    c.consumerKey,
 		"consumerSecret": c.consumerSecret,
 	}
 }
+
+func SignOAuth1(req *http.Request, consumerKey, consumerSecret, token, tokenSecret, nonce string, timestamp int64) error {
+	o := &oauth1Credentials{
+		consumerKey:    consumerKey,
+		consumerSecret: consumerSecret,
+		token:          token,
+		tokenSecret:    tokenSecret,
+	}
+	return o.sign(req, nonce, timestamp)
+}
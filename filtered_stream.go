@@ -6,9 +6,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
 	"net/http"
-	"sync"
 )
 
 func addOrDeleteRules(ctx context.Context, c *client, body *AddOrDeleteJSONBody, opt ...*AddOrDeleteRulesOption) (*AddOrDeleteRulesResponse, error) {
@@ -33,7 +31,7 @@ func addOrDeleteRules(ctx context.Context, c *client, body *AddOrDeleteJSONBody,
 		return nil, errors.New("add or delete rules : can not marshal")
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, addOrDeleteRulesURL, bytes.NewBuffer(j))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+addOrDeleteRulesURL, bytes.NewBuffer(j))
 	if err != nil {
 		return nil, fmt.Errorf("add or delete rules new request with ctx: %w", err)
 	}
@@ -51,7 +49,7 @@ func addOrDeleteRules(ctx context.Context, c *client, body *AddOrDeleteJSONBody,
 	}
 	topt.addQuery(req)
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("add or delete rules: %w", err)
 	}
@@ -62,18 +60,14 @@ func addOrDeleteRules(ctx context.Context, c *client, body *AddOrDeleteJSONBody,
 		return nil, fmt.Errorf("add or delete rules decode: %w", err)
 	}
 	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		return &addOrDelete, &HTTPError{
-			APIName: "add or delete",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &addOrDelete, newAPIError("add or delete", req, resp)
 	}
 
 	return &addOrDelete, nil
 }
 
 func retrieveStreamRules(ctx context.Context, c *client, opt ...*RetrieveStreamRulesOption) (*RetrieveStreamRulesResponse, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, retrieveStreamRulesURL, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+retrieveStreamRulesURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("retrieve stream rules new request with ctx: %w", err)
 	}
@@ -90,7 +84,7 @@ func retrieveStreamRules(ctx context.Context, c *client, opt ...*RetrieveStreamR
 	}
 	topt.addQuery(req)
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("retrieve stream rules: %w", err)
 	}
@@ -101,79 +95,26 @@ func retrieveStreamRules(ctx context.Context, c *client, opt ...*RetrieveStreamR
 		return nil, fmt.Errorf("retrieve stream rules decode: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &tweet, &HTTPError{
-			APIName: "retrieve stream rules",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &tweet, newAPIError("retrieve stream rules", req, resp)
 	}
 
 	return &tweet, nil
 }
 
-func (s *ConnectToStream) Stop() {
-	close(s.done)
-	s.wg.Wait()
-}
-
-func (s *ConnectToStream) retry(req *http.Request) {
-	defer s.wg.Done()
-	resp, err := s.client.Do(req)
-	if err != nil {
-		s.errCh <- err
-		return
-	}
-	defer resp.Body.Close()
-	if resp.StatusCode != http.StatusOK {
-		s.errCh <- &HTTPError{
-			APIName: "connect to stream",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
-		return
-	}
-	dec := json.NewDecoder(resp.Body)
-
-	for !stopped(s.done) {
-		var connectToStream ConnectToStreamResponse
-		err := dec.Decode(&connectToStream)
-		if err != nil {
-			s.errCh <- err
-			if err == io.EOF {
-				break
-			}
-		}
-		s.ch <- connectToStream
-	}
-}
-
 func connectToStream(ctx context.Context, c *client, ch chan<- ConnectToStreamResponse, errCh chan<- error, opt ...*ConnectToStreamOption) *ConnectToStream {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, connectToStreamURL, nil)
-	if err != nil {
-		errCh <- fmt.Errorf("connect to stream new request with ctx: %w", err)
-	}
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.bearerToken))
-
-	var copt ConnectToStreamOption
+	var (
+		copt ConnectToStreamOption
+		err  error
+	)
 	switch len(opt) {
 	case 0:
 		// do nothing
 	case 1:
 		copt = *opt[0]
 	default:
-		errCh <- errors.New("connect to stream: only one option is allowed")
+		err = errors.New("connect to stream: only one option is allowed")
 	}
-	copt.addQuery(req)
-
-	s := &ConnectToStream{
-		client: c.client,
-		errCh:  errCh,
-		ch:     ch,
-		done:   make(chan struct{}),
-		wg:     &sync.WaitGroup{},
+	return &ConnectToStream{
+		stream: newStream(ctx, c, "connect to stream", connectToStreamURL, ch, errCh, copt.settings(), copt.addQuery, err),
 	}
-
-	s.wg.Add(1)
-	go s.retry(req)
-	return s
 }
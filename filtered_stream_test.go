@@ -2,10 +2,15 @@ package gotwtr_test
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 
@@ -66,7 +71,7 @@ func Test_retrieveStreamRules(t *testing.T) {
 					},
 				},
 				Meta: &gotwtr.RetrieveStreamRulesMeta{
-					Sent: "2020-06-18T15:21:58.638Z",
+					Sent: mustParseTime("2020-06-18T15:21:58.638Z"),
 				},
 			},
 			wantErr: false,
@@ -106,7 +111,7 @@ func Test_retrieveStreamRules(t *testing.T) {
 					},
 				},
 				Meta: &gotwtr.RetrieveStreamRulesMeta{
-					Sent: "2020-06-18T15:21:58.638Z",
+					Sent: mustParseTime("2020-06-18T15:21:58.638Z"),
 				},
 			},
 			wantErr: false,
@@ -242,7 +247,7 @@ func Test_AddOrDeleteRules(t *testing.T) {
 					},
 				},
 				Meta: &gotwtr.AddOrDeleteRulesMeta{
-					Sent: "2020-06-18T15:20:24.063Z",
+					Sent: mustParseTime("2020-06-18T15:20:24.063Z"),
 					Summary: &gotwtr.AddOrDeleteMetaSummary{
 						Created:    2,
 						NotCreated: 0,
@@ -302,7 +307,7 @@ func Test_AddOrDeleteRules(t *testing.T) {
 					},
 				},
 				Meta: &gotwtr.AddOrDeleteRulesMeta{
-					Sent: "2020-06-18T16:00:33.972Z",
+					Sent: mustParseTime("2020-06-18T16:00:33.972Z"),
 					Summary: &gotwtr.AddOrDeleteMetaSummary{
 						Created:    1,
 						NotCreated: 0,
@@ -342,7 +347,7 @@ func Test_AddOrDeleteRules(t *testing.T) {
 			},
 			want: &gotwtr.AddOrDeleteRulesResponse{
 				Meta: &gotwtr.AddOrDeleteRulesMeta{
-					Sent: "2020-07-09T21:13:18.284Z",
+					Sent: mustParseTime("2020-07-09T21:13:18.284Z"),
 					Summary: &gotwtr.AddOrDeleteMetaSummary{
 						Deleted:    1,
 						NotDeleted: 0,
@@ -507,3 +512,126 @@ func Test_ConnectToStream(t *testing.T) {
 		})
 	}
 }
+
+func Test_ConnectToStreamReconnect(t *testing.T) {
+	t.Parallel()
+	var (
+		mu      sync.Mutex
+		queries []string
+		states  []gotwtr.StreamState
+	)
+	c := gotwtr.New("key", gotwtr.WithHTTPClient(mockHTTPClient(func(req *http.Request) *http.Response {
+		mu.Lock()
+		queries = append(queries, req.URL.Query().Get("backfill_minutes"))
+		n := len(queries)
+		mu.Unlock()
+		body := fmt.Sprintf(`{"data":{"id":"%d","text":"hello"}}`+"\r\n", n)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+		}
+	})))
+	ch := make(chan gotwtr.ConnectToStreamResponse)
+	errCh := make(chan error, 10)
+	stream := c.ConnectToStream(context.Background(), ch, errCh, &gotwtr.ConnectToStreamOption{
+		BackfillMinutes: 2,
+		OnStateChange: func(state gotwtr.StreamState, err error) {
+			mu.Lock()
+			defer mu.Unlock()
+			states = append(states, state)
+		},
+	})
+	for _, want := range []string{"1", "2"} {
+		select {
+		case got := <-ch:
+			if got.Tweet.ID != want {
+				t.Errorf("client.ConnectToStream() got Tweet %s, want %s", got.Tweet.ID, want)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("client.ConnectToStream() did not reconnect")
+		}
+	}
+	if err := <-errCh; !errors.Is(err, io.EOF) {
+		t.Errorf("client.ConnectToStream() error = %v, want %v", err, io.EOF)
+	}
+	stream.Stop()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if diff := cmp.Diff([]string{"", "2"}, queries[:2]); diff != "" {
+		t.Errorf("backfill_minutes mismatch (-want +got):\n%s", diff)
+	}
+	wantStates := []gotwtr.StreamState{gotwtr.StreamConnecting, gotwtr.StreamConnected, gotwtr.StreamDisconnected, gotwtr.StreamConnecting, gotwtr.StreamConnected}
+	if diff := cmp.Diff(wantStates, states[:len(wantStates)]); diff != "" {
+		t.Errorf("OnStateChange() mismatch (-want +got):\n%s", diff)
+	}
+	if got := states[len(states)-1]; got != gotwtr.StreamStopped {
+		t.Errorf("last state = %v, want %v", got, gotwtr.StreamStopped)
+	}
+}
+
+func Test_ConnectToStreamStall(t *testing.T) {
+	t.Parallel()
+	c := gotwtr.New("key", gotwtr.WithHTTPClient(mockHTTPClient(func(req *http.Request) *http.Response {
+		// the body never sends anything until the request is canceled.
+		pr, pw := io.Pipe()
+		go func() {
+			<-req.Context().Done()
+			pw.Close()
+		}()
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       pr,
+		}
+	})))
+	ch := make(chan gotwtr.ConnectToStreamResponse)
+	errCh := make(chan error, 1)
+	stream := c.ConnectToStream(context.Background(), ch, errCh, &gotwtr.ConnectToStreamOption{
+		StallTimeout:     50 * time.Millisecond,
+		DisableReconnect: true,
+	})
+	select {
+	case err := <-errCh:
+		if !errors.Is(err, gotwtr.ErrStreamStalled) {
+			t.Errorf("client.ConnectToStream() error = %v, want %v", err, gotwtr.ErrStreamStalled)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("client.ConnectToStream() did not detect the stall")
+	}
+	stream.Stop()
+}
+
+func Test_ConnectToStreamPermanentError(t *testing.T) {
+	t.Parallel()
+	var requests int32
+	c := gotwtr.New("key", gotwtr.WithHTTPClient(mockHTTPClient(func(req *http.Request) *http.Response {
+		atomic.AddInt32(&requests, 1)
+		return &http.Response{
+			StatusCode: http.StatusUnauthorized,
+			Status:     "401 Unauthorized",
+			Body:       io.NopCloser(strings.NewReader(`{"title":"Unauthorized","type":"about:blank","status":401,"detail":"Unauthorized"}`)),
+		}
+	})))
+	ch := make(chan gotwtr.ConnectToStreamResponse)
+	errCh := make(chan error, 1)
+	stopped := make(chan error, 1)
+	stream := c.ConnectToStream(context.Background(), ch, errCh, &gotwtr.ConnectToStreamOption{
+		OnStateChange: func(state gotwtr.StreamState, err error) {
+			if state == gotwtr.StreamStopped {
+				stopped <- err
+			}
+		},
+	})
+	select {
+	case err := <-stopped:
+		if !errors.Is(err, gotwtr.ErrUnauthorized) {
+			t.Errorf("client.ConnectToStream() stopped with %v, want %v", err, gotwtr.ErrUnauthorized)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("client.ConnectToStream() kept reconnecting")
+	}
+	stream.Stop()
+	if got := atomic.LoadInt32(&requests); got != 1 {
+		t.Errorf("client.ConnectToStream() sent %d requests, want 1", got)
+	}
+}
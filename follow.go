@@ -13,7 +13,7 @@ func followers(ctx context.Context, c *client, userID string, opt ...*FollowOpti
 	if userID == "" {
 		return nil, errors.New("followers: userID parameter is required")
 	}
-	ep := fmt.Sprintf(followersURL, userID)
+	ep := c.baseURL + fmt.Sprintf(followersURL, userID)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ep, nil)
 	if err != nil {
@@ -44,7 +44,7 @@ func followers(ctx context.Context, c *client, userID string, opt ...*FollowOpti
 	}
 	fopt.addQuery(req)
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("followers response: %w", err)
 	}
@@ -56,11 +56,7 @@ func followers(ctx context.Context, c *client, userID string, opt ...*FollowOpti
 		return nil, fmt.Errorf("followers by id decode: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &f, &HTTPError{
-			APIName: "followers",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &f, newAPIError("followers", req, resp)
 	}
 
 	return &f, nil
@@ -70,7 +66,7 @@ func following(ctx context.Context, c *client, userID string, opt ...*FollowOpti
 	if userID == "" {
 		return nil, errors.New("following: userID parameter is required")
 	}
-	ep := fmt.Sprintf(followingURL, userID)
+	ep := c.baseURL + fmt.Sprintf(followingURL, userID)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ep, nil)
 	if err != nil {
@@ -101,7 +97,7 @@ func following(ctx context.Context, c *client, userID string, opt ...*FollowOpti
 	}
 	fopt.addQuery(req)
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("following response: %w", err)
 	}
@@ -113,11 +109,7 @@ func following(ctx context.Context, c *client, userID string, opt ...*FollowOpti
 		return nil, fmt.Errorf("following: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &f, &HTTPError{
-			APIName: "following",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &f, newAPIError("following", req, resp)
 	}
 
 	return &f, nil
@@ -127,7 +119,7 @@ func postFollowing(ctx context.Context, c *client, userID string, targetUserID s
 	if userID == "" {
 		return nil, errors.New("post following: userID parameter is required")
 	}
-	ep := fmt.Sprintf(postFollowingURL, userID)
+	ep := c.baseURL + fmt.Sprintf(postFollowingURL, userID)
 
 	if targetUserID == "" {
 		return nil, errors.New("post following: targetUserID parameter is required")
@@ -147,7 +139,7 @@ func postFollowing(ctx context.Context, c *client, userID string, targetUserID s
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.bearerToken))
 	req.Header.Set("Content-Type", "application/json")
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("post following response: %w", err)
 	}
@@ -158,11 +150,7 @@ func postFollowing(ctx context.Context, c *client, userID string, targetUserID s
 		return nil, fmt.Errorf("post following decode: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &postFollowing, &HTTPError{
-			APIName: "post following",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &postFollowing, newAPIError("post following", req, resp)
 	}
 
 	return &postFollowing, nil
@@ -175,7 +163,7 @@ func undoFollowing(ctx context.Context, c *client, sourceUserID string, targetUs
 	if targetUserID == "" {
 		return nil, errors.New("undo following: targetUserID parameter is required")
 	}
-	ep := fmt.Sprintf(undoFollowingURL, sourceUserID, targetUserID)
+	ep := c.baseURL + fmt.Sprintf(undoFollowingURL, sourceUserID, targetUserID)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, ep, nil)
 	if err != nil {
@@ -183,7 +171,7 @@ func undoFollowing(ctx context.Context, c *client, sourceUserID string, targetUs
 	}
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.bearerToken))
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("undo following response: %w", err)
 	}
@@ -194,11 +182,7 @@ func undoFollowing(ctx context.Context, c *client, sourceUserID string, targetUs
 		return nil, fmt.Errorf("undo following decode: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &undoFollowing, &HTTPError{
-			APIName: "undo following",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &undoFollowing, newAPIError("undo following", req, resp)
 	}
 
 	return &undoFollowing, nil
@@ -0,0 +1,150 @@
+package gotwtrtest
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/sivchari/gotwtr"
+)
+
+func (s *Server) complianceRoutes(rs []*route) []*route {
+	// Batch compliance
+	rs = handle(rs, http.MethodGet, "/2/compliance/jobs", s.complianceJobs)
+	rs = handle(rs, http.MethodGet, "/2/compliance/jobs/:", s.complianceJob)
+	rs = handle(rs, http.MethodPost, "/2/compliance/jobs", s.createComplianceJob)
+	// Upload and download locations handed out in upload_url and download_url.
+	rs = handle(rs, http.MethodPut, "/compliance/upload/:", s.uploadComplianceIDs)
+	rs = handle(rs, http.MethodGet, "/compliance/download/:", s.downloadComplianceResults)
+	return rs
+}
+
+func (s *Server) complianceJobs(w http.ResponseWriter, r *http.Request, _ []string) {
+	q := r.URL.Query()
+	if q.Get("type") == "" {
+		writeProblem(w, http.StatusBadRequest, "Invalid Request", "The `type` query parameter can not be empty")
+		return
+	}
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	jobs := []*gotwtr.ComplianceJobData{}
+	for i := len(s.store.jobOrder) - 1; i >= 0; i-- {
+		job := s.store.jobs[s.store.jobOrder[i]].data
+		if job.Type != q.Get("type") {
+			continue
+		}
+		if status := q.Get("status"); status != "" && job.Status != status {
+			continue
+		}
+		jobs = append(jobs, job)
+	}
+	writeJSON(w, http.StatusOK, &envelope{
+		Data: jobs,
+	})
+}
+
+func (s *Server) complianceJob(w http.ResponseWriter, r *http.Request, params []string) {
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	job, ok := s.store.jobs[params[0]]
+	if !ok {
+		writeJSON(w, http.StatusOK, &envelope{
+			Errors: []*gotwtr.APIResponseError{notFound("compliance_job", "id", params[0])},
+		})
+		return
+	}
+	writeJSON(w, http.StatusOK, &envelope{
+		Data: job.data,
+	})
+}
+
+func (s *Server) createComplianceJob(w http.ResponseWriter, r *http.Request, _ []string) {
+	var body gotwtr.CreateComplianceJobOption
+	if !decodeBody(w, r, &body) {
+		return
+	}
+	if body.Type != gotwtr.ComplianceFieldTypeTweets && body.Type != gotwtr.ComplianceFieldTypeUsers {
+		writeProblem(w, http.StatusBadRequest, "Invalid Request", "The `type` field must be one of tweets, users")
+		return
+	}
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	id := s.store.nextID()
+	now := s.store.now().UTC()
+	job := &gotwtr.ComplianceJobData{
+		ID:                id,
+		CreatedAt:         gotwtr.NewTime(now),
+		Type:              string(body.Type),
+		Name:              body.Name,
+		UploadURL:         s.URL + "/compliance/upload/" + id,
+		UploadExpiresAt:   gotwtr.NewTime(now.Add(15 * time.Minute)),
+		DownloadURL:       s.URL + "/compliance/download/" + id,
+		DownloadExpiresAt: gotwtr.NewTime(now.Add(7 * 24 * time.Hour)),
+		Status:            string(gotwtr.ComplianseFieldStatusCreated),
+		Resumable:         body.Resumable,
+	}
+	s.store.jobs[id] = &complianceJob{data: job}
+	s.store.jobOrder = append(s.store.jobOrder, id)
+	writeJSON(w, http.StatusOK, &envelope{
+		Data: job,
+	})
+}
+
+// uploadComplianceIDs receives the newline separated ID file of a job and completes the job at once.
+func (s *Server) uploadComplianceIDs(w http.ResponseWriter, r *http.Request, params []string) {
+	var ids []string
+	sc := bufio.NewScanner(r.Body)
+	for sc.Scan() {
+		if id := strings.TrimSpace(sc.Text()); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		writeProblem(w, http.StatusBadRequest, "Invalid Request", err.Error())
+		return
+	}
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	job, ok := s.store.jobs[params[0]]
+	if !ok {
+		writeProblem(w, http.StatusNotFound, "Not Found Error", "Could not find compliance_job with id: ["+params[0]+"].")
+		return
+	}
+	job.ids = ids
+	job.data.Status = string(gotwtr.ComplianseFieldStatusCompletae)
+	w.WriteHeader(http.StatusOK)
+}
+
+// downloadComplianceResults serves one JSON line per uploaded ID that is no longer in the store.
+func (s *Server) downloadComplianceResults(w http.ResponseWriter, r *http.Request, params []string) {
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	job, ok := s.store.jobs[params[0]]
+	if !ok || job.data.Status != string(gotwtr.ComplianseFieldStatusCompletae) {
+		writeProblem(w, http.StatusNotFound, "Not Found Error", "Could not find results of compliance_job with id: ["+params[0]+"].")
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	now := s.store.timestamp()
+	for _, id := range job.ids {
+		var exists bool
+		if job.data.Type == string(gotwtr.ComplianceFieldTypeTweets) {
+			_, exists = s.store.tweets[id]
+		} else {
+			_, exists = s.store.users[id]
+		}
+		if exists {
+			continue
+		}
+		_ = enc.Encode(&gotwtr.ComplianceResult{
+			ID:         id,
+			Action:     "delete",
+			CreatedAt:  now,
+			RedactedAt: now,
+			Reason:     "deleted",
+		})
+	}
+}
@@ -0,0 +1,165 @@
+package gotwtrtest
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/sivchari/gotwtr"
+)
+
+func (s *Server) directMessageRoutes(rs []*route) []*route {
+	// Manage Direct Message
+	rs = handle(rs, http.MethodPost, "/2/dm_conversations/with/:/messages", s.createOneToOneDM)
+	rs = handle(rs, http.MethodPost, "/2/dm_conversations/:/messages", s.createNewGroupDM)
+	rs = handle(rs, http.MethodPost, "/2/dm_conversations", s.postDM)
+	// LookUp Direct Message
+	rs = handle(rs, http.MethodGet, "/2/dm_conversations/with/:/dm_events", s.lookUpAllOneToOneDM)
+	rs = handle(rs, http.MethodGet, "/2/dm_conversations/:/dm_events", s.lookUpDM)
+	rs = handle(rs, http.MethodGet, "/2/dm_events", s.lookUpAllDM)
+	return rs
+}
+
+type dmCreated struct {
+	DMConversationID string `json:"dm_conversation_id"`
+	DMEventID        string `json:"dm_event_id"`
+}
+
+// oneToOneConversationID returns the ID of the one-to-one conversation between two users,
+// which the API builds from both user IDs joined by a hyphen, lowest first.
+func oneToOneConversationID(a, b string) string {
+	ids := []string{a, b}
+	sort.Strings(ids)
+	return strings.Join(ids, "-")
+}
+
+// addMessage stores a MessageCreate event and returns it. It must be called with the store locked.
+func (s *store) addMessage(conversationID, text string, attachments []gotwtr.DirectMessageAttachment) *gotwtr.DirectMessage {
+	dm := &gotwtr.DirectMessage{
+		Attachments:      attachments,
+		CreatedAt:        s.timestamp(),
+		DMConversationID: conversationID,
+		EventType:        string(gotwtr.EventTypesFieldMessageCreate),
+		ID:               s.nextID(),
+		SenderID:         s.me,
+		Text:             text,
+	}
+	s.dmEvents = append(s.dmEvents, dm)
+	return dm
+}
+
+func (s *Server) createOneToOneDM(w http.ResponseWriter, r *http.Request, params []string) {
+	var body gotwtr.CreateOneToOneDMBody
+	if !decodeBody(w, r, &body) {
+		return
+	}
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	if _, ok := s.store.users[params[0]]; !ok {
+		writeProblem(w, http.StatusBadRequest, "Invalid Request", "Could not find user with participant_id: ["+params[0]+"].")
+		return
+	}
+	cid := oneToOneConversationID(s.store.me, params[0])
+	s.store.conversations[cid] = []string{s.store.me, params[0]}
+	dm := s.store.addMessage(cid, body.Text, body.Attachments)
+	writeJSON(w, http.StatusCreated, &dmCreated{DMConversationID: cid, DMEventID: dm.ID})
+}
+
+func (s *Server) createNewGroupDM(w http.ResponseWriter, r *http.Request, params []string) {
+	var body gotwtr.CreateNewGroupDMBody
+	if !decodeBody(w, r, &body) {
+		return
+	}
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	if _, ok := s.store.conversations[params[0]]; !ok {
+		writeProblem(w, http.StatusNotFound, "Not Found Error", "Could not find dm_conversation with id: ["+params[0]+"].")
+		return
+	}
+	dm := s.store.addMessage(params[0], body.Text, body.Attachments)
+	writeJSON(w, http.StatusCreated, &dmCreated{DMConversationID: params[0], DMEventID: dm.ID})
+}
+
+func (s *Server) postDM(w http.ResponseWriter, r *http.Request, _ []string) {
+	var body gotwtr.PostDMBody
+	if !decodeBody(w, r, &body) {
+		return
+	}
+	if body.ConversationType != "Group" || len(body.ParticipantIDs) == 0 || body.Message == nil {
+		writeProblem(w, http.StatusBadRequest, "Invalid Request", "One or more parameters to your request was invalid.")
+		return
+	}
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	cid := s.store.nextID()
+	s.store.conversations[cid] = append([]string{s.store.me}, body.ParticipantIDs...)
+	s.store.dmEvents = append(s.store.dmEvents, &gotwtr.DirectMessage{
+		CreatedAt:        s.store.timestamp(),
+		DMConversationID: cid,
+		EventType:        string(gotwtr.EventTypesFieldParticipantsJoin),
+		ID:               s.store.nextID(),
+		ParticipantIDs:   s.store.conversations[cid],
+		SenderID:         s.store.me,
+	})
+	dm := s.store.addMessage(cid, body.Message.Text, body.Message.Attachments)
+	writeJSON(w, http.StatusCreated, &dmCreated{DMConversationID: cid, DMEventID: dm.ID})
+}
+
+// writeDMPage serves the events of the conversations accepted by fn, most recent first.
+func (s *Server) writeDMPage(w http.ResponseWriter, r *http.Request, fn func(dm *gotwtr.DirectMessage) bool) {
+	eventTypes := splitIDs(r.URL.Query().Get("event_types"))
+	var events []*gotwtr.DirectMessage
+	for i := len(s.store.dmEvents) - 1; i >= 0; i-- {
+		dm := s.store.dmEvents[i]
+		if !fn(dm) {
+			continue
+		}
+		if len(eventTypes) > 0 && !contains(eventTypes, dm.EventType) {
+			continue
+		}
+		events = append(events, dm)
+	}
+	start, end, next := page(r, len(events), 100)
+	events = events[start:end]
+	writeJSON(w, http.StatusOK, &envelope{
+		Data: events,
+		Meta: &meta{
+			ResultCount: len(events),
+			NextToken:   next,
+		},
+	})
+}
+
+func (s *Server) lookUpAllOneToOneDM(w http.ResponseWriter, r *http.Request, params []string) {
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	cid := oneToOneConversationID(s.store.me, params[0])
+	s.writeDMPage(w, r, func(dm *gotwtr.DirectMessage) bool {
+		return dm.DMConversationID == cid
+	})
+}
+
+func (s *Server) lookUpDM(w http.ResponseWriter, r *http.Request, params []string) {
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	s.writeDMPage(w, r, func(dm *gotwtr.DirectMessage) bool {
+		return dm.DMConversationID == params[0]
+	})
+}
+
+func (s *Server) lookUpAllDM(w http.ResponseWriter, r *http.Request, _ []string) {
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	s.writeDMPage(w, r, func(dm *gotwtr.DirectMessage) bool {
+		return contains(s.store.conversations[dm.DMConversationID], s.store.me)
+	})
+}
+
+func contains(ss []string, v string) bool {
+	for _, s := range ss {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,253 @@
+package gotwtrtest
+
+import (
+	"net/http"
+
+	"github.com/sivchari/gotwtr"
+)
+
+func (s *Server) listRoutes(rs []*route) []*route {
+	// List lookup
+	rs = handle(rs, http.MethodGet, "/2/lists/:", s.lookUpList)
+	rs = handle(rs, http.MethodGet, "/2/users/:/owned_lists", s.lookUpAllListsOwned)
+	// Manage Lists
+	rs = handle(rs, http.MethodPost, "/2/lists", s.createNewList)
+	rs = handle(rs, http.MethodDelete, "/2/lists/:", s.deleteList)
+	rs = handle(rs, http.MethodPut, "/2/lists/:", s.updateMetaDataForList)
+	// List Tweets lookup
+	rs = handle(rs, http.MethodGet, "/2/lists/:/tweets", s.lookUpListTweets)
+	// List members
+	rs = handle(rs, http.MethodGet, "/2/lists/:/members", s.listUsers(&s.store.listMembers))
+	rs = handle(rs, http.MethodGet, "/2/users/:/list_memberships", s.userLists(&s.store.listMembers, true))
+	rs = handle(rs, http.MethodPost, "/2/lists/:/members", s.postListMembers)
+	rs = handle(rs, http.MethodDelete, "/2/lists/:/members/:", s.undoListMembers)
+	// List follows
+	rs = handle(rs, http.MethodGet, "/2/lists/:/followers", s.listUsers(&s.store.listFollowers))
+	rs = handle(rs, http.MethodGet, "/2/users/:/followed_lists", s.userLists(&s.store.listFollowers, true))
+	rs = handle(rs, http.MethodPost, "/2/users/:/followed_lists", s.postUserList(&s.store.listFollowers, true, "following"))
+	rs = handle(rs, http.MethodDelete, "/2/users/:/followed_lists/:", s.undoUserList(&s.store.listFollowers, true, "following"))
+	// Pinned Lists
+	rs = handle(rs, http.MethodGet, "/2/users/:/pinned_lists", s.userLists(&s.store.pinnedLists, false))
+	rs = handle(rs, http.MethodPost, "/2/users/:/pinned_lists", s.postUserList(&s.store.pinnedLists, false, "pinned"))
+	rs = handle(rs, http.MethodDelete, "/2/users/:/pinned_lists/:", s.undoUserList(&s.store.pinnedLists, false, "pinned"))
+	return rs
+}
+
+// listIncludes returns the includes requested by the expansions query parameter. It must be called with the store locked.
+func (s *Server) listIncludes(r *http.Request, lists []*gotwtr.List) *includes {
+	if !hasExpansion(r, string(gotwtr.ExpansionOwnerID)) {
+		return nil
+	}
+	var inc includes
+	for _, l := range lists {
+		if u, ok := s.store.users[l.OwnerID]; ok {
+			inc.Users = append(inc.Users, u)
+		}
+	}
+	if len(inc.Users) == 0 {
+		return nil
+	}
+	return &inc
+}
+
+func (s *Server) writeListPage(w http.ResponseWriter, r *http.Request, lists []*gotwtr.List, defaultMaxResults int) {
+	start, end, next := page(r, len(lists), defaultMaxResults)
+	lists = lists[start:end]
+	writeJSON(w, http.StatusOK, &envelope{
+		Data:     lists,
+		Includes: s.listIncludes(r, lists),
+		Meta: &meta{
+			ResultCount: len(lists),
+			NextToken:   next,
+		},
+	})
+}
+
+func (s *Server) lookUpList(w http.ResponseWriter, r *http.Request, params []string) {
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	l, ok := s.store.lists[params[0]]
+	if !ok {
+		writeJSON(w, http.StatusOK, &envelope{
+			Errors: []*gotwtr.APIResponseError{notFound("list", "id", params[0])},
+		})
+		return
+	}
+	writeJSON(w, http.StatusOK, &envelope{
+		Data:     l,
+		Includes: s.listIncludes(r, []*gotwtr.List{l}),
+	})
+}
+
+func (s *Server) lookUpAllListsOwned(w http.ResponseWriter, r *http.Request, params []string) {
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	var lists []*gotwtr.List
+	for _, id := range s.store.listOrder {
+		if l := s.store.lists[id]; l.OwnerID == params[0] {
+			lists = append(lists, l)
+		}
+	}
+	s.writeListPage(w, r, lists, 100)
+}
+
+func (s *Server) createNewList(w http.ResponseWriter, r *http.Request, _ []string) {
+	var body gotwtr.CreateNewListBody
+	if !decodeBody(w, r, &body) {
+		return
+	}
+	if body.Name == "" {
+		writeProblem(w, http.StatusBadRequest, "Invalid Request", "The `name` field can not be empty")
+		return
+	}
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	l := &gotwtr.List{
+		ID:          s.store.nextID(),
+		Name:        body.Name,
+		Description: body.Description,
+		Private:     body.Private,
+		OwnerID:     s.store.me,
+		CreatedAt:   s.store.timestamp(),
+	}
+	s.store.putList(l)
+	writeJSON(w, http.StatusCreated, &envelope{
+		Data: &gotwtr.CreateNewListData{ID: l.ID, Name: l.Name},
+	})
+}
+
+func (s *Server) deleteList(w http.ResponseWriter, r *http.Request, params []string) {
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	writeJSON(w, http.StatusOK, &envelope{
+		Data: &gotwtr.DeleteListData{Deleted: s.store.deleteList(params[0])},
+	})
+}
+
+func (s *Server) updateMetaDataForList(w http.ResponseWriter, r *http.Request, params []string) {
+	var body gotwtr.UpdateMetaDataForListBody
+	if !decodeBody(w, r, &body) {
+		return
+	}
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	l, ok := s.store.lists[params[0]]
+	if ok {
+		if body.Name != "" {
+			l.Name = body.Name
+		}
+		if body.Description != "" {
+			l.Description = body.Description
+		}
+		l.Private = body.Private
+	}
+	writeJSON(w, http.StatusOK, &envelope{
+		Data: &gotwtr.UpdateMetaDataForListData{Updated: ok},
+	})
+}
+
+func (s *Server) lookUpListTweets(w http.ResponseWriter, r *http.Request, params []string) {
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	members := map[string]bool{}
+	for _, id := range s.store.listMembers.targets(params[0]) {
+		members[id] = true
+	}
+	tweets := s.store.recentTweets(func(t *gotwtr.Tweet) bool {
+		return members[t.AuthorID]
+	})
+	s.writeTweetPage(w, r, tweets, 100)
+}
+
+// listUsers serves the users related to the List in the path through rel.
+func (s *Server) listUsers(rel *relation) handlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, params []string) {
+		s.store.mu.Lock()
+		defer s.store.mu.Unlock()
+		s.writeUserPage(w, r, s.store.usersByIDs(rel.targets(params[0])), 100)
+	}
+}
+
+// userLists serves the Lists related to the user in the path through rel.
+// listFirst reports whether the edges of rel are stored as list -> user.
+func (s *Server) userLists(rel *relation, listFirst bool) handlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, params []string) {
+		s.store.mu.Lock()
+		defer s.store.mu.Unlock()
+		ids := rel.targets(params[0])
+		if listFirst {
+			ids = rel.sources(params[0])
+		}
+		s.writeListPage(w, r, s.store.listsByIDs(ids), 100)
+	}
+}
+
+func (s *Server) postListMembers(w http.ResponseWriter, r *http.Request, params []string) {
+	var body gotwtr.ListMembersBody
+	if !decodeBody(w, r, &body) {
+		return
+	}
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	if _, ok := s.store.lists[params[0]]; !ok {
+		writeProblem(w, http.StatusNotFound, "Not Found Error", "Could not find list with id: ["+params[0]+"].")
+		return
+	}
+	if _, ok := s.store.users[body.UserID]; !ok {
+		writeProblem(w, http.StatusBadRequest, "Invalid Request", "Could not find user with user_id: ["+body.UserID+"].")
+		return
+	}
+	s.store.listMembers.add(params[0], body.UserID)
+	writeJSON(w, http.StatusOK, &envelope{
+		Data: &gotwtr.IsMember{IsMember: true},
+	})
+}
+
+func (s *Server) undoListMembers(w http.ResponseWriter, r *http.Request, params []string) {
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	s.store.listMembers.remove(params[0], params[1])
+	writeJSON(w, http.StatusOK, &envelope{
+		Data: &gotwtr.IsMember{IsMember: false},
+	})
+}
+
+func (s *Server) postUserList(rel *relation, listFirst bool, key string) handlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, params []string) {
+		var body struct {
+			ListID string `json:"list_id"`
+		}
+		if !decodeBody(w, r, &body) {
+			return
+		}
+		s.store.mu.Lock()
+		defer s.store.mu.Unlock()
+		if _, ok := s.store.lists[body.ListID]; !ok {
+			writeProblem(w, http.StatusBadRequest, "Invalid Request", "Could not find list with list_id: ["+body.ListID+"].")
+			return
+		}
+		if listFirst {
+			rel.add(body.ListID, params[0])
+		} else {
+			rel.add(params[0], body.ListID)
+		}
+		writeJSON(w, http.StatusOK, &envelope{
+			Data: map[string]bool{key: true},
+		})
+	}
+}
+
+func (s *Server) undoUserList(rel *relation, listFirst bool, key string) handlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, params []string) {
+		s.store.mu.Lock()
+		defer s.store.mu.Unlock()
+		if listFirst {
+			rel.remove(params[1], params[0])
+		} else {
+			rel.remove(params[0], params[1])
+		}
+		writeJSON(w, http.StatusOK, &envelope{
+			Data: map[string]bool{key: false},
+		})
+	}
+}
@@ -0,0 +1,193 @@
+package gotwtrtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/sivchari/gotwtr"
+)
+
+// UploadedMedia is a media uploaded through the chunked media upload.
+type UploadedMedia struct {
+	ID            string
+	MediaType     string
+	MediaCategory gotwtr.MediaCategory
+	Data          []byte
+	AltText       string
+	Subtitles     []*gotwtr.MediaSubtitle
+
+	total     int64
+	finalized bool
+}
+
+func (s *Server) mediaRoutes(rs []*route) []*route {
+	rs = handle(rs, http.MethodPost, "/1.1/media/upload.json", s.mediaUpload)
+	rs = handle(rs, http.MethodGet, "/1.1/media/upload.json", s.mediaUploadStatus)
+	rs = handle(rs, http.MethodPost, "/1.1/media/metadata/create.json", s.createMediaMetadata)
+	rs = handle(rs, http.MethodPost, "/1.1/media/subtitles/create.json", s.createMediaSubtitles)
+	return rs
+}
+
+// Media returns a copy of the uploaded media with the given ID.
+func (s *Server) Media(id string) (*UploadedMedia, bool) {
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	m, ok := s.store.media[id]
+	if !ok {
+		return nil, false
+	}
+	c := *m
+	return &c, true
+}
+
+// processed reports whether Twitter processes media of the category asynchronously, as it does for videos and GIFs.
+func processed(category gotwtr.MediaCategory) bool {
+	switch category {
+	case gotwtr.MediaCategoryTweetVideo, gotwtr.MediaCategoryTweetGIF, gotwtr.MediaCategoryDMVideo, gotwtr.MediaCategoryDMGIF:
+		return true
+	default:
+		return false
+	}
+}
+
+func mediaResponse(m *UploadedMedia, state string) *gotwtr.MediaUploadResponse {
+	id, _ := strconv.ParseInt(m.ID, 10, 64)
+	resp := &gotwtr.MediaUploadResponse{
+		MediaID:          id,
+		MediaIDString:    m.ID,
+		Size:             int64(len(m.Data)),
+		ExpiresAfterSecs: 86400,
+	}
+	if state != "" {
+		resp.ProcessingInfo = &gotwtr.MediaProcessingInfo{State: state}
+		if state == gotwtr.MediaProcessingPending {
+			resp.ProcessingInfo.CheckAfterSecs = 1
+		} else {
+			resp.ProcessingInfo.ProgressPercent = 100
+		}
+	}
+	return resp
+}
+
+func (s *Server) mediaUpload(w http.ResponseWriter, r *http.Request, _ []string) {
+	if err := r.ParseMultipartForm(8 << 20); err != nil && err != http.ErrNotMultipart {
+		writeProblem(w, http.StatusBadRequest, "Invalid Request", err.Error())
+		return
+	}
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	switch r.FormValue("command") {
+	case "INIT":
+		total, err := strconv.ParseInt(r.FormValue("total_bytes"), 10, 64)
+		if err != nil || total <= 0 || r.FormValue("media_type") == "" {
+			writeProblem(w, http.StatusBadRequest, "Invalid Request", "media_type and total_bytes are required")
+			return
+		}
+		m := &UploadedMedia{
+			ID:            s.store.nextID(),
+			MediaType:     r.FormValue("media_type"),
+			MediaCategory: gotwtr.MediaCategory(r.FormValue("media_category")),
+			total:         total,
+		}
+		s.store.media[m.ID] = m
+		writeJSON(w, http.StatusAccepted, mediaResponse(m, ""))
+	case "APPEND":
+		m, ok := s.store.media[r.FormValue("media_id")]
+		if !ok || m.finalized {
+			writeProblem(w, http.StatusBadRequest, "Invalid Request", "unknown media_id")
+			return
+		}
+		f, _, err := r.FormFile("media")
+		if err != nil {
+			writeProblem(w, http.StatusBadRequest, "Invalid Request", "media is required")
+			return
+		}
+		defer f.Close()
+		var buf bytes.Buffer
+		if _, err := io.Copy(&buf, f); err != nil {
+			writeProblem(w, http.StatusBadRequest, "Invalid Request", err.Error())
+			return
+		}
+		m.Data = append(m.Data, buf.Bytes()...)
+		w.WriteHeader(http.StatusNoContent)
+	case "FINALIZE":
+		m, ok := s.store.media[r.FormValue("media_id")]
+		if !ok || int64(len(m.Data)) != m.total {
+			writeProblem(w, http.StatusBadRequest, "Invalid Request", "the uploaded size does not match total_bytes")
+			return
+		}
+		m.finalized = true
+		state := ""
+		if processed(m.MediaCategory) {
+			state = gotwtr.MediaProcessingPending
+		}
+		writeJSON(w, http.StatusCreated, mediaResponse(m, state))
+	default:
+		writeProblem(w, http.StatusBadRequest, "Invalid Request", "unknown command")
+	}
+}
+
+// mediaUploadStatus reports every finalized media as processed.
+func (s *Server) mediaUploadStatus(w http.ResponseWriter, r *http.Request, _ []string) {
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	m, ok := s.store.media[r.URL.Query().Get("media_id")]
+	if r.URL.Query().Get("command") != "STATUS" || !ok || !m.finalized {
+		writeProblem(w, http.StatusBadRequest, "Invalid Request", "unknown media_id")
+		return
+	}
+	writeJSON(w, http.StatusOK, mediaResponse(m, gotwtr.MediaProcessingSucceeded))
+}
+
+func (s *Server) createMediaMetadata(w http.ResponseWriter, r *http.Request, _ []string) {
+	var body struct {
+		MediaID string `json:"media_id"`
+		AltText struct {
+			Text string `json:"text"`
+		} `json:"alt_text"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeProblem(w, http.StatusBadRequest, "Invalid Request", err.Error())
+		return
+	}
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	m, ok := s.store.media[body.MediaID]
+	if !ok {
+		writeProblem(w, http.StatusBadRequest, "Invalid Request", "unknown media_id")
+		return
+	}
+	m.AltText = body.AltText.Text
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) createMediaSubtitles(w http.ResponseWriter, r *http.Request, _ []string) {
+	var body struct {
+		MediaID      string `json:"media_id"`
+		SubtitleInfo struct {
+			Subtitles []*gotwtr.MediaSubtitle `json:"subtitles"`
+		} `json:"subtitle_info"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeProblem(w, http.StatusBadRequest, "Invalid Request", err.Error())
+		return
+	}
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	m, ok := s.store.media[body.MediaID]
+	if !ok {
+		writeProblem(w, http.StatusBadRequest, "Invalid Request", "unknown media_id")
+		return
+	}
+	for _, sub := range body.SubtitleInfo.Subtitles {
+		if _, ok := s.store.media[sub.MediaID]; !ok {
+			writeProblem(w, http.StatusBadRequest, "Invalid Request", "unknown subtitles media_id "+sub.MediaID)
+			return
+		}
+	}
+	m.Subtitles = append(m.Subtitles, body.SubtitleInfo.Subtitles...)
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,308 @@
+// Package gotwtrtest provides an in-memory fake of the Twitter v2 API for integration tests.
+//
+// The fake serves the endpoints covered by gotwtr.Twtr for tweets, users, follows, blocks, mutes,
+// likes, retweets, bookmarks, lists, direct messages, compliance jobs, streams and media uploads from a mutable store,
+// so that writes such as PostTweet or PostFollowing are visible to the following lookups.
+// Field, expansion and pagination parameters are honored on a best-effort basis;
+// responses always carry every field that is known to the store.
+package gotwtrtest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sivchari/gotwtr"
+)
+
+// Server is a fake Twitter v2 API server backed by an in-memory store.
+type Server struct {
+	*httptest.Server
+
+	store     *store
+	routes    []*route
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// NewServer starts and returns a new Server. The caller should call Close when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{
+		store: newStore(),
+		done:  make(chan struct{}),
+	}
+	s.routes = s.buildRoutes()
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Close terminates open streams and shuts down the server.
+func (s *Server) Close() {
+	s.closeOnce.Do(func() {
+		close(s.done)
+	})
+	s.Server.Close()
+}
+
+// Client returns a gotwtr.Client that talks to s. opts are applied after the options that point the client at s.
+func (s *Server) Client(opts ...gotwtr.ClientOption) *gotwtr.Client {
+	o := []gotwtr.ClientOption{
+		gotwtr.WithHTTPClient(s.Server.Client()),
+		gotwtr.WithBaseURL(s.URL),
+		gotwtr.WithUploadURL(s.URL),
+		gotwtr.WithAuthURL(s.URL),
+	}
+	return gotwtr.New("gotwtrtest", append(o, opts...)...)
+}
+
+// SetNow replaces the clock used for created_at timestamps.
+func (s *Server) SetNow(now func() time.Time) {
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	s.store.now = now
+}
+
+// SetMe sets the authenticated user returned by Me and used as the author of posted Tweets, Lists and Direct Messages.
+// The user is added to the store when it is not known yet.
+func (s *Server) SetMe(u *gotwtr.User) {
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	s.store.putUser(u)
+	s.store.me = u.ID
+}
+
+// AddUser adds or replaces a user in the store.
+func (s *Server) AddUser(u *gotwtr.User) {
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	s.store.putUser(u)
+}
+
+// AddTweet adds or replaces a Tweet in the store. An empty ID is filled with a newly issued one.
+func (s *Server) AddTweet(t *gotwtr.Tweet) *gotwtr.Tweet {
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	if t.ID == "" {
+		t.ID = s.store.nextID()
+	}
+	if t.CreatedAt.IsZero() {
+		t.CreatedAt = s.store.timestamp()
+	}
+	if t.ConversationID == "" {
+		t.ConversationID = t.ID
+	}
+	if len(t.EditHistoryIDs) == 0 {
+		t.EditHistoryIDs = []string{t.ID}
+	}
+	s.store.putTweet(t)
+	return t
+}
+
+// AddList adds or replaces a List in the store. An empty ID is filled with a newly issued one.
+func (s *Server) AddList(l *gotwtr.List) *gotwtr.List {
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	if l.ID == "" {
+		l.ID = s.store.nextID()
+	}
+	s.store.putList(l)
+	return l
+}
+
+// Tweet returns a copy of the stored Tweet with the given ID, which the handlers keep using while the test changes it.
+func (s *Server) Tweet(id string) (*gotwtr.Tweet, bool) {
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	t, ok := s.store.tweets[id]
+	if !ok {
+		return nil, false
+	}
+	c := *t
+	return &c, true
+}
+
+// User returns a copy of the stored user with the given ID, which the handlers keep using while the test changes it.
+func (s *Server) User(id string) (*gotwtr.User, bool) {
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	u, ok := s.store.users[id]
+	if !ok {
+		return nil, false
+	}
+	c := *u
+	return &c, true
+}
+
+// Rules returns the filtered stream rules currently stored.
+func (s *Server) Rules() []*gotwtr.FilteredRule {
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	return append([]*gotwtr.FilteredRule(nil), s.store.rules...)
+}
+
+type handlerFunc func(w http.ResponseWriter, r *http.Request, params []string)
+
+type route struct {
+	method  string
+	pattern []string
+	handle  handlerFunc
+}
+
+func (rt *route) match(method string, segments []string) ([]string, bool) {
+	if rt.method != method || len(rt.pattern) != len(segments) {
+		return nil, false
+	}
+	var params []string
+	for i, p := range rt.pattern {
+		switch {
+		case p == ":":
+			params = append(params, segments[i])
+		case p != segments[i]:
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+// handle registers h for method and pattern. A ":" segment in pattern matches any path segment
+// and is passed to h in order of appearance.
+func handle(routes []*route, method, pattern string, h handlerFunc) []*route {
+	return append(routes, &route{
+		method:  method,
+		pattern: strings.Split(strings.Trim(pattern, "/"), "/"),
+		handle:  h,
+	})
+}
+
+func (s *Server) buildRoutes() []*route {
+	var rs []*route
+	rs = s.tweetRoutes(rs)
+	rs = s.userRoutes(rs)
+	rs = s.listRoutes(rs)
+	rs = s.directMessageRoutes(rs)
+	rs = s.complianceRoutes(rs)
+	rs = s.streamRoutes(rs)
+	rs = s.mediaRoutes(rs)
+	return rs
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if segments[0] == "2" && r.Header.Get("Authorization") == "" {
+		writeProblem(w, http.StatusUnauthorized, "Unauthorized", "Unauthorized")
+		return
+	}
+	for _, rt := range s.routes {
+		if params, ok := rt.match(r.Method, segments); ok {
+			rt.handle(w, r, params)
+			return
+		}
+	}
+	writeProblem(w, http.StatusNotFound, "Not Found Error", "The requested resource does not exist: "+r.Method+" "+r.URL.Path)
+}
+
+type meta struct {
+	ResultCount int    `json:"result_count"`
+	NewestID    string `json:"newest_id,omitempty"`
+	OldestID    string `json:"oldest_id,omitempty"`
+	NextToken   string `json:"next_token,omitempty"`
+}
+
+type includes struct {
+	Users  []*gotwtr.User  `json:"users,omitempty"`
+	Tweets []*gotwtr.Tweet `json:"tweets,omitempty"`
+}
+
+type envelope struct {
+	Data     interface{}                `json:"data,omitempty"`
+	Includes *includes                  `json:"includes,omitempty"`
+	Errors   []*gotwtr.APIResponseError `json:"errors,omitempty"`
+	Meta     *meta                      `json:"meta,omitempty"`
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeProblem(w http.ResponseWriter, status int, title, detail string) {
+	typ := "about:blank"
+	switch status {
+	case http.StatusBadRequest:
+		typ = "https://api.twitter.com/2/problems/invalid-request"
+	case http.StatusNotFound:
+		typ = "https://api.twitter.com/2/problems/resource-not-found"
+	}
+	writeJSON(w, status, map[string]interface{}{
+		"title":  title,
+		"detail": detail,
+		"type":   typ,
+		"status": status,
+	})
+}
+
+func notFound(resourceType, parameter, id string) *gotwtr.APIResponseError {
+	return &gotwtr.APIResponseError{
+		Value:        id,
+		Detail:       "Could not find " + resourceType + " with " + parameter + ": [" + id + "].",
+		Title:        "Not Found Error",
+		ResourceType: resourceType,
+		Parameter:    parameter,
+		ResourceID:   id,
+		Type:         "https://api.twitter.com/2/problems/resource-not-found",
+	}
+}
+
+func decodeBody(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeProblem(w, http.StatusBadRequest, "Invalid Request", "One or more parameters to your request was invalid: "+err.Error())
+		return false
+	}
+	return true
+}
+
+func splitIDs(v string) []string {
+	if v == "" {
+		return nil
+	}
+	return strings.Split(v, ",")
+}
+
+// page slices n items according to the max_results and pagination_token (or next_token) query parameters.
+// It returns the bounds of the page and the token of the following page, if any.
+func page(r *http.Request, n, defaultMaxResults int) (start, end int, next string) {
+	q := r.URL.Query()
+	token := q.Get("pagination_token")
+	if token == "" {
+		token = q.Get("next_token")
+	}
+	if token != "" {
+		start, _ = strconv.Atoi(token)
+	}
+	max := defaultMaxResults
+	if m, err := strconv.Atoi(q.Get("max_results")); err == nil && m > 0 {
+		max = m
+	}
+	if start > n {
+		start = n
+	}
+	end = start + max
+	if end >= n {
+		return start, n, ""
+	}
+	return start, end, strconv.Itoa(end)
+}
+
+func hasExpansion(r *http.Request, expansion string) bool {
+	for _, e := range splitIDs(r.URL.Query().Get("expansions")) {
+		if e == expansion {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,346 @@
+package gotwtrtest_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sivchari/gotwtr"
+	"github.com/sivchari/gotwtr/gotwtrtest"
+)
+
+func newServer(t *testing.T) (*gotwtrtest.Server, *gotwtr.Client) {
+	t.Helper()
+	srv := gotwtrtest.NewServer()
+	t.Cleanup(srv.Close)
+	srv.SetMe(&gotwtr.User{ID: "2244994945", Name: "Twitter Dev", UserName: "TwitterDev"})
+	srv.AddUser(&gotwtr.User{ID: "6253282", Name: "Twitter API", UserName: "TwitterAPI"})
+	return srv, srv.Client()
+}
+
+func TestServer_tweets(t *testing.T) {
+	t.Parallel()
+	_, c := newServer(t)
+	ctx := context.Background()
+
+	posted, err := c.PostTweet(ctx, &gotwtr.PostTweetOption{Text: "Hello world"})
+	if err != nil {
+		t.Fatalf("PostTweet() error = %v", err)
+	}
+	reply, err := c.PostTweet(ctx, &gotwtr.PostTweetOption{
+		Text:  "@TwitterDev hi",
+		Reply: &gotwtr.TweetReply{InReplyToTweetID: posted.PostTweetData.ID},
+	})
+	if err != nil {
+		t.Fatalf("PostTweet() error = %v", err)
+	}
+
+	got, err := c.RetrieveSingleTweet(ctx, reply.PostTweetData.ID, &gotwtr.RetriveTweetOption{
+		Expansions: []gotwtr.Expansion{gotwtr.ExpansionAuthorID},
+	})
+	if err != nil {
+		t.Fatalf("RetrieveSingleTweet() error = %v", err)
+	}
+	if got.Tweet.ConversationID != posted.PostTweetData.ID || got.Tweet.InReplyToUserID != "2244994945" {
+		t.Errorf("RetrieveSingleTweet() = %+v, want reply in conversation %s", got.Tweet, posted.PostTweetData.ID)
+	}
+	if got.Includes == nil || len(got.Includes.Users) != 1 || got.Includes.Users[0].UserName != "TwitterDev" {
+		t.Errorf("RetrieveSingleTweet() includes = %+v, want author", got.Includes)
+	}
+
+	search, err := c.SearchRecentTweets(ctx, "conversation_id:"+posted.PostTweetData.ID+" is:reply")
+	if err != nil {
+		t.Fatalf("SearchRecentTweets() error = %v", err)
+	}
+	if len(search.Tweets) != 1 || search.Tweets[0].ID != reply.PostTweetData.ID {
+		t.Errorf("SearchRecentTweets() = %+v, want only the reply", search.Tweets)
+	}
+
+	if _, err := c.DeleteTweet(ctx, posted.PostTweetData.ID); err != nil {
+		t.Fatalf("DeleteTweet() error = %v", err)
+	}
+	missing, err := c.RetrieveSingleTweet(ctx, posted.PostTweetData.ID)
+	if err != nil {
+		t.Fatalf("RetrieveSingleTweet() error = %v", err)
+	}
+	if missing.Tweet != nil || len(missing.Errors) != 1 {
+		t.Errorf("RetrieveSingleTweet() = %+v, want not found error", missing)
+	}
+}
+
+func TestServer_accessorsReturnCopies(t *testing.T) {
+	t.Parallel()
+	srv, c := newServer(t)
+	ctx := context.Background()
+	added := srv.AddTweet(&gotwtr.Tweet{Text: "Hello world", AuthorID: "2244994945"})
+
+	tw, ok := srv.Tweet(added.ID)
+	if !ok {
+		t.Fatalf("Tweet(%s) not found", added.ID)
+	}
+	tw.Text = "changed"
+	u, ok := srv.User("2244994945")
+	if !ok {
+		t.Fatal("User(2244994945) not found")
+	}
+	u.UserName = "changed"
+
+	got, err := c.RetrieveSingleTweet(ctx, added.ID, &gotwtr.RetriveTweetOption{
+		Expansions: []gotwtr.Expansion{gotwtr.ExpansionAuthorID},
+	})
+	if err != nil {
+		t.Fatalf("RetrieveSingleTweet() error = %v", err)
+	}
+	if got.Tweet.Text != "Hello world" || got.Includes.Users[0].UserName != "TwitterDev" {
+		t.Errorf("RetrieveSingleTweet() = %+v, %+v, want the stored values unchanged", got.Tweet, got.Includes.Users[0])
+	}
+}
+
+func TestServer_follows(t *testing.T) {
+	t.Parallel()
+	_, c := newServer(t)
+	ctx := context.Background()
+
+	if _, err := c.PostFollowing(ctx, "2244994945", "6253282"); err != nil {
+		t.Fatalf("PostFollowing() error = %v", err)
+	}
+	following, err := c.Following(ctx, "2244994945")
+	if err != nil {
+		t.Fatalf("Following() error = %v", err)
+	}
+	want := []*gotwtr.User{{ID: "6253282", Name: "Twitter API", UserName: "TwitterAPI"}}
+	if diff := cmp.Diff(want, following.Users); diff != "" {
+		t.Errorf("Following() mismatch (-want +got):\n%s", diff)
+	}
+	followers, err := c.Followers(ctx, "6253282")
+	if err != nil {
+		t.Fatalf("Followers() error = %v", err)
+	}
+	if len(followers.Users) != 1 || followers.Users[0].ID != "2244994945" {
+		t.Errorf("Followers() = %+v, want TwitterDev", followers.Users)
+	}
+
+	if _, err := c.UndoFollowing(ctx, "2244994945", "6253282"); err != nil {
+		t.Fatalf("UndoFollowing() error = %v", err)
+	}
+	following, err = c.Following(ctx, "2244994945")
+	if err != nil {
+		t.Fatalf("Following() error = %v", err)
+	}
+	if len(following.Users) != 0 {
+		t.Errorf("Following() = %+v, want none", following.Users)
+	}
+}
+
+func TestServer_pagination(t *testing.T) {
+	t.Parallel()
+	srv, c := newServer(t)
+	ctx := context.Background()
+	for i := 0; i < 5; i++ {
+		srv.AddTweet(&gotwtr.Tweet{Text: "tweet", AuthorID: "6253282"})
+	}
+
+	var (
+		ids   []string
+		token string
+	)
+	for {
+		resp, err := c.UserTweetTimeline(ctx, "6253282", &gotwtr.UserTweetTimelineOption{
+			MaxResults:      5,
+			PaginationToken: token,
+		})
+		if err != nil {
+			t.Fatalf("UserTweetTimeline() error = %v", err)
+		}
+		for _, tw := range resp.Tweets {
+			ids = append(ids, tw.ID)
+		}
+		if resp.Meta.NextToken == "" || len(ids) > 5 {
+			break
+		}
+		token = resp.Meta.NextToken
+	}
+	if len(ids) != 5 {
+		t.Errorf("UserTweetTimeline() returned %d tweets, want 5", len(ids))
+	}
+}
+
+func TestServer_lists(t *testing.T) {
+	t.Parallel()
+	srv, c := newServer(t)
+	ctx := context.Background()
+	srv.AddTweet(&gotwtr.Tweet{Text: "from a member", AuthorID: "6253282"})
+
+	created, err := c.CreateNewList(ctx, &gotwtr.CreateNewListBody{Name: "devs"})
+	if err != nil {
+		t.Fatalf("CreateNewList() error = %v", err)
+	}
+	lid := created.CreateNewListData.ID
+	if _, err := c.PostListMembers(ctx, lid, "6253282"); err != nil {
+		t.Fatalf("PostListMembers() error = %v", err)
+	}
+	if _, err := c.PostListFollows(ctx, lid, "2244994945"); err != nil {
+		t.Fatalf("PostListFollows() error = %v", err)
+	}
+
+	tweets, err := c.LookUpListTweets(ctx, lid)
+	if err != nil {
+		t.Fatalf("LookUpListTweets() error = %v", err)
+	}
+	if len(tweets.Tweets) != 1 || tweets.Tweets[0].Text != "from a member" {
+		t.Errorf("LookUpListTweets() = %+v, want the member tweet", tweets.Tweets)
+	}
+	follows, err := c.AllListsUserFollows(ctx, "2244994945")
+	if err != nil {
+		t.Fatalf("AllListsUserFollows() error = %v", err)
+	}
+	if len(follows.Lists) != 1 || follows.Lists[0].ID != lid {
+		t.Errorf("AllListsUserFollows() = %+v, want %s", follows.Lists, lid)
+	}
+	owned, err := c.LookUpAllListsOwned(ctx, "2244994945")
+	if err != nil {
+		t.Fatalf("LookUpAllListsOwned() error = %v", err)
+	}
+	if len(owned.Lists) != 1 || owned.Lists[0].Name != "devs" {
+		t.Errorf("LookUpAllListsOwned() = %+v, want devs", owned.Lists)
+	}
+}
+
+func TestServer_bookmarks(t *testing.T) {
+	t.Parallel()
+	srv, c := newServer(t)
+	ctx := context.Background()
+	tw := srv.AddTweet(&gotwtr.Tweet{Text: "save me", AuthorID: "6253282"})
+
+	if _, err := c.BookmarkTweet(ctx, "2244994945", &gotwtr.BookmarkTweetBody{TweetID: tw.ID}); err != nil {
+		t.Fatalf("BookmarkTweet() error = %v", err)
+	}
+	got, err := c.LookupUserBookmarks(ctx, "2244994945")
+	if err != nil {
+		t.Fatalf("LookupUserBookmarks() error = %v", err)
+	}
+	if len(got.Tweets) != 1 || got.Tweets[0].ID != tw.ID {
+		t.Errorf("LookupUserBookmarks() = %+v, want %s", got.Tweets, tw.ID)
+	}
+	if _, err := c.RemoveBookmarkOfTweet(ctx, "2244994945", tw.ID); err != nil {
+		t.Fatalf("RemoveBookmarkOfTweet() error = %v", err)
+	}
+	got, err = c.LookupUserBookmarks(ctx, "2244994945")
+	if err != nil {
+		t.Fatalf("LookupUserBookmarks() error = %v", err)
+	}
+	if len(got.Tweets) != 0 {
+		t.Errorf("LookupUserBookmarks() = %+v, want none", got.Tweets)
+	}
+}
+
+func TestServer_directMessages(t *testing.T) {
+	t.Parallel()
+	_, c := newServer(t)
+	ctx := context.Background()
+
+	created, err := c.CreateOneToOneDM(ctx, "6253282", &gotwtr.CreateOneToOneDMBody{Text: "hi"})
+	if err != nil {
+		t.Fatalf("CreateOneToOneDM() error = %v", err)
+	}
+	if _, err := c.CreateNewGroupDM(ctx, created.DMConversationID, &gotwtr.CreateNewGroupDMBody{Text: "again"}); err != nil {
+		t.Fatalf("CreateNewGroupDM() error = %v", err)
+	}
+	got, err := c.LookUpAllOneToOneDM(ctx, "6253282")
+	if err != nil {
+		t.Fatalf("LookUpAllOneToOneDM() error = %v", err)
+	}
+	var texts []string
+	for _, dm := range got.Message {
+		texts = append(texts, dm.Text)
+	}
+	if diff := cmp.Diff([]string{"again", "hi"}, texts); diff != "" {
+		t.Errorf("LookUpAllOneToOneDM() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestServer_complianceJob(t *testing.T) {
+	t.Parallel()
+	srv, c := newServer(t)
+	ctx := context.Background()
+	tw := srv.AddTweet(&gotwtr.Tweet{Text: "still here"})
+
+	created, err := c.CreateComplianceJob(ctx, &gotwtr.CreateComplianceJobOption{Type: gotwtr.ComplianceFieldTypeTweets})
+	if err != nil {
+		t.Fatalf("CreateComplianceJob() error = %v", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, created.CreateComplianceJobData.UploadURL, strings.NewReader(tw.ID+"\n1\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("upload error = %v", err)
+	}
+	resp.Body.Close()
+
+	resp, err = http.Get(created.CreateComplianceJobData.DownloadURL)
+	if err != nil {
+		t.Fatalf("download error = %v", err)
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lines := strings.Split(strings.TrimSpace(string(b)), "\n"); len(lines) != 1 || !strings.Contains(lines[0], `"id":"1"`) {
+		t.Errorf("download = %q, want a single result for id 1", b)
+	}
+}
+
+func TestServer_streams(t *testing.T) {
+	t.Parallel()
+	srv, c := newServer(t)
+	ctx := context.Background()
+
+	if _, err := c.AddOrDeleteRules(ctx, &gotwtr.AddOrDeleteJSONBody{
+		Add: []*gotwtr.AddRule{{Value: "gopher", Tag: "go"}},
+	}); err != nil {
+		t.Fatalf("AddOrDeleteRules() error = %v", err)
+	}
+	rules, err := c.RetrieveStreamRules(ctx)
+	if err != nil {
+		t.Fatalf("RetrieveStreamRules() error = %v", err)
+	}
+	if len(rules.Rules) != 1 || rules.Rules[0].Tag != "go" {
+		t.Fatalf("RetrieveStreamRules() = %+v, want the gopher rule", rules.Rules)
+	}
+
+	ch := make(chan gotwtr.ConnectToStreamResponse, 8)
+	errCh := make(chan error, 8)
+	stream := c.ConnectToStream(ctx, ch, errCh)
+	timeout := time.After(5 * time.Second)
+	tick := time.NewTicker(20 * time.Millisecond)
+	defer tick.Stop()
+	for received := false; !received; {
+		select {
+		case <-tick.C:
+			// the stream may not be connected yet, so keep posting until a tweet arrives.
+			srv.AddTweet(&gotwtr.Tweet{Text: "ignored"})
+			if _, err := c.PostTweet(ctx, &gotwtr.PostTweetOption{Text: "hello gopher"}); err != nil {
+				t.Fatalf("PostTweet() error = %v", err)
+			}
+		case got := <-ch:
+			if got.Tweet.Text != "hello gopher" || len(got.MatchingRules) != 1 || got.MatchingRules[0].Tag != "go" {
+				t.Errorf("ConnectToStream() = %+v, want the matching tweet", got)
+			}
+			received = true
+		case err := <-errCh:
+			t.Fatalf("ConnectToStream() error = %v", err)
+		case <-timeout:
+			t.Fatal("ConnectToStream() did not receive a tweet")
+		}
+	}
+	srv.Close()
+	stream.Stop()
+}
@@ -0,0 +1,261 @@
+package gotwtrtest
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/sivchari/gotwtr"
+)
+
+const firstID = 1500000000000000000
+
+// relation is an ordered set of directed edges such as follower -> followee or user -> liked tweet.
+type relation struct {
+	pairs [][2]string
+}
+
+func (r *relation) add(src, dst string) bool {
+	if r.has(src, dst) {
+		return false
+	}
+	r.pairs = append(r.pairs, [2]string{src, dst})
+	return true
+}
+
+func (r *relation) remove(src, dst string) bool {
+	for i, p := range r.pairs {
+		if p[0] == src && p[1] == dst {
+			r.pairs = append(r.pairs[:i], r.pairs[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func (r *relation) removeAll(id string) {
+	pairs := r.pairs[:0]
+	for _, p := range r.pairs {
+		if p[0] != id && p[1] != id {
+			pairs = append(pairs, p)
+		}
+	}
+	r.pairs = pairs
+}
+
+func (r *relation) has(src, dst string) bool {
+	for _, p := range r.pairs {
+		if p[0] == src && p[1] == dst {
+			return true
+		}
+	}
+	return false
+}
+
+// targets returns the destinations of src, most recently added first.
+func (r *relation) targets(src string) []string {
+	var ids []string
+	for i := len(r.pairs) - 1; i >= 0; i-- {
+		if r.pairs[i][0] == src {
+			ids = append(ids, r.pairs[i][1])
+		}
+	}
+	return ids
+}
+
+// sources returns the origins pointing at dst, most recently added first.
+func (r *relation) sources(dst string) []string {
+	var ids []string
+	for i := len(r.pairs) - 1; i >= 0; i-- {
+		if r.pairs[i][1] == dst {
+			ids = append(ids, r.pairs[i][0])
+		}
+	}
+	return ids
+}
+
+type complianceJob struct {
+	data *gotwtr.ComplianceJobData
+	ids  []string
+}
+
+type store struct {
+	mu  sync.Mutex
+	seq int64
+	now func() time.Time
+
+	me string
+
+	users     map[string]*gotwtr.User
+	userOrder []string
+
+	tweets     map[string]*gotwtr.Tweet
+	tweetOrder []string
+	hidden     map[string]bool
+
+	lists     map[string]*gotwtr.List
+	listOrder []string
+
+	following     relation
+	blocking      relation
+	muting        relation
+	likes         relation
+	retweets      relation
+	bookmarks     relation
+	listMembers   relation
+	listFollowers relation
+	pinnedLists   relation
+
+	dmEvents      []*gotwtr.DirectMessage
+	conversations map[string][]string
+
+	jobs     map[string]*complianceJob
+	jobOrder []string
+
+	rules []*gotwtr.FilteredRule
+
+	media map[string]*UploadedMedia
+
+	subscribers map[chan *gotwtr.Tweet]struct{}
+}
+
+func newStore() *store {
+	return &store{
+		seq:           firstID,
+		now:           time.Now,
+		users:         map[string]*gotwtr.User{},
+		tweets:        map[string]*gotwtr.Tweet{},
+		hidden:        map[string]bool{},
+		lists:         map[string]*gotwtr.List{},
+		conversations: map[string][]string{},
+		jobs:          map[string]*complianceJob{},
+		media:         map[string]*UploadedMedia{},
+		subscribers:   map[chan *gotwtr.Tweet]struct{}{},
+	}
+}
+
+// nextID returns a new numeric ID that sorts after every ID issued before it.
+func (s *store) nextID() string {
+	s.seq++
+	return strconv.FormatInt(s.seq, 10)
+}
+
+func (s *store) timestamp() gotwtr.Time {
+	return gotwtr.NewTime(s.now().UTC())
+}
+
+func (s *store) putUser(u *gotwtr.User) {
+	if _, ok := s.users[u.ID]; !ok {
+		s.userOrder = append(s.userOrder, u.ID)
+	}
+	s.users[u.ID] = u
+}
+
+func (s *store) userByName(name string) *gotwtr.User {
+	for _, id := range s.userOrder {
+		if u := s.users[id]; u.UserName == name {
+			return u
+		}
+	}
+	return nil
+}
+
+func (s *store) putTweet(t *gotwtr.Tweet) {
+	if _, ok := s.tweets[t.ID]; !ok {
+		s.tweetOrder = append(s.tweetOrder, t.ID)
+	}
+	s.tweets[t.ID] = t
+}
+
+func (s *store) deleteTweet(id string) bool {
+	if _, ok := s.tweets[id]; !ok {
+		return false
+	}
+	delete(s.tweets, id)
+	delete(s.hidden, id)
+	for i, tid := range s.tweetOrder {
+		if tid == id {
+			s.tweetOrder = append(s.tweetOrder[:i], s.tweetOrder[i+1:]...)
+			break
+		}
+	}
+	s.likes.removeAll(id)
+	s.retweets.removeAll(id)
+	s.bookmarks.removeAll(id)
+	return true
+}
+
+// recentTweets returns the stored tweets matching fn, most recent first.
+func (s *store) recentTweets(fn func(t *gotwtr.Tweet) bool) []*gotwtr.Tweet {
+	var tweets []*gotwtr.Tweet
+	for i := len(s.tweetOrder) - 1; i >= 0; i-- {
+		if t := s.tweets[s.tweetOrder[i]]; fn(t) {
+			tweets = append(tweets, t)
+		}
+	}
+	return tweets
+}
+
+func (s *store) putList(l *gotwtr.List) {
+	if _, ok := s.lists[l.ID]; !ok {
+		s.listOrder = append(s.listOrder, l.ID)
+	}
+	s.lists[l.ID] = l
+}
+
+func (s *store) deleteList(id string) bool {
+	if _, ok := s.lists[id]; !ok {
+		return false
+	}
+	delete(s.lists, id)
+	for i, lid := range s.listOrder {
+		if lid == id {
+			s.listOrder = append(s.listOrder[:i], s.listOrder[i+1:]...)
+			break
+		}
+	}
+	s.listMembers.removeAll(id)
+	s.listFollowers.removeAll(id)
+	s.pinnedLists.removeAll(id)
+	return true
+}
+
+func (s *store) usersByIDs(ids []string) []*gotwtr.User {
+	users := make([]*gotwtr.User, 0, len(ids))
+	for _, id := range ids {
+		if u, ok := s.users[id]; ok {
+			users = append(users, u)
+		}
+	}
+	return users
+}
+
+func (s *store) tweetsByIDs(ids []string) []*gotwtr.Tweet {
+	tweets := make([]*gotwtr.Tweet, 0, len(ids))
+	for _, id := range ids {
+		if t, ok := s.tweets[id]; ok {
+			tweets = append(tweets, t)
+		}
+	}
+	return tweets
+}
+
+func (s *store) listsByIDs(ids []string) []*gotwtr.List {
+	lists := make([]*gotwtr.List, 0, len(ids))
+	for _, id := range ids {
+		if l, ok := s.lists[id]; ok {
+			lists = append(lists, l)
+		}
+	}
+	return lists
+}
+
+func (s *store) publish(t *gotwtr.Tweet) {
+	for ch := range s.subscribers {
+		select {
+		case ch <- t:
+		default:
+			// drop the tweet for slow subscribers rather than block writers.
+		}
+	}
+}
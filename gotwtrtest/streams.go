@@ -0,0 +1,206 @@
+package gotwtrtest
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/sivchari/gotwtr"
+)
+
+func (s *Server) streamRoutes(rs []*route) []*route {
+	// Filtered stream
+	rs = handle(rs, http.MethodGet, "/2/tweets/search/stream/rules", s.retrieveStreamRules)
+	rs = handle(rs, http.MethodPost, "/2/tweets/search/stream/rules", s.addOrDeleteRules)
+	rs = handle(rs, http.MethodGet, "/2/tweets/search/stream", s.connectToStream)
+	// Volume streams
+	rs = handle(rs, http.MethodGet, "/2/tweets/sample/stream", s.volumeStreams)
+	rs = handle(rs, http.MethodGet, "/2/tweets/sample10/stream", s.volumeStreams10)
+	return rs
+}
+
+func (s *Server) retrieveStreamRules(w http.ResponseWriter, r *http.Request, _ []string) {
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	ids := splitIDs(r.URL.Query().Get("ids"))
+	rules := []*gotwtr.FilteredRule{}
+	for _, rule := range s.store.rules {
+		if len(ids) == 0 || contains(ids, rule.ID) {
+			rules = append(rules, rule)
+		}
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"data": rules,
+		"meta": map[string]interface{}{
+			"sent":         s.store.timestamp(),
+			"result_count": len(rules),
+		},
+	})
+}
+
+func (s *Server) addOrDeleteRules(w http.ResponseWriter, r *http.Request, _ []string) {
+	var body gotwtr.AddOrDeleteJSONBody
+	if !decodeBody(w, r, &body) {
+		return
+	}
+	dryRun := r.URL.Query().Get("dry_run") == "true"
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	var (
+		summary gotwtr.AddOrDeleteMetaSummary
+		created []*gotwtr.FilteredRule
+		errs    []*gotwtr.APIResponseError
+	)
+	rules := append([]*gotwtr.FilteredRule(nil), s.store.rules...)
+	for _, add := range body.Add {
+		duplicate := false
+		for _, rule := range rules {
+			if rule.Value == add.Value {
+				duplicate = true
+				break
+			}
+		}
+		if duplicate {
+			summary.NotCreated++
+			errs = append(errs, &gotwtr.APIResponseError{
+				Value: add.Value,
+				Title: "DuplicateRule",
+				Type:  "https://api.twitter.com/2/problems/duplicate-rules",
+			})
+			continue
+		}
+		rule := &gotwtr.FilteredRule{ID: s.store.nextID(), Value: add.Value, Tag: add.Tag}
+		rules = append(rules, rule)
+		created = append(created, rule)
+		summary.Created++
+		summary.Valid++
+	}
+	if body.Delete != nil {
+		for _, id := range body.Delete.IDs {
+			deleted := false
+			for i, rule := range rules {
+				if rule.ID == id {
+					rules = append(rules[:i], rules[i+1:]...)
+					deleted = true
+					break
+				}
+			}
+			if deleted {
+				summary.Deleted++
+			} else {
+				summary.NotDeleted++
+			}
+		}
+	}
+	if !dryRun {
+		s.store.rules = rules
+	}
+	status := http.StatusOK
+	if len(body.Add) > 0 {
+		status = http.StatusCreated
+	}
+	writeJSON(w, status, map[string]interface{}{
+		"data":   created,
+		"errors": errs,
+		"meta": &gotwtr.AddOrDeleteRulesMeta{
+			Sent:    s.store.timestamp(),
+			Summary: &summary,
+		},
+	})
+}
+
+// subscribe registers a channel that receives every Tweet posted while the request is open.
+func (s *Server) subscribe() (<-chan *gotwtr.Tweet, func()) {
+	ch := make(chan *gotwtr.Tweet, 64)
+	s.store.mu.Lock()
+	s.store.subscribers[ch] = struct{}{}
+	s.store.mu.Unlock()
+	return ch, func() {
+		s.store.mu.Lock()
+		delete(s.store.subscribers, ch)
+		s.store.mu.Unlock()
+	}
+}
+
+// stream writes newline delimited JSON objects built by fn for each posted Tweet until the client goes away or the server is closed.
+// fn returns nil to skip a Tweet. It is called with the store locked.
+func (s *Server) stream(w http.ResponseWriter, r *http.Request, fn func(t *gotwtr.Tweet) interface{}) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeProblem(w, http.StatusInternalServerError, "Internal Server Error", "streaming is not supported")
+		return
+	}
+	ch, unsubscribe := s.subscribe()
+	defer unsubscribe()
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+	enc := json.NewEncoder(w)
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-s.done:
+			return
+		case t := <-ch:
+			s.store.mu.Lock()
+			v := fn(t)
+			s.store.mu.Unlock()
+			if v == nil {
+				continue
+			}
+			if err := enc.Encode(v); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+func (s *Server) connectToStream(w http.ResponseWriter, r *http.Request, _ []string) {
+	s.stream(w, r, func(t *gotwtr.Tweet) interface{} {
+		var matching []*gotwtr.MatchingRule
+		for _, rule := range s.store.rules {
+			if s.store.match(strings.TrimSpace(rule.Value), t) {
+				matching = append(matching, &gotwtr.MatchingRule{ID: rule.ID, Tag: rule.Tag})
+			}
+		}
+		if len(matching) == 0 {
+			return nil
+		}
+		return &gotwtr.ConnectToStreamResponse{
+			Tweet:         t,
+			Includes:      s.streamIncludes(r, t),
+			MatchingRules: matching,
+		}
+	})
+}
+
+func (s *Server) volumeStreams(w http.ResponseWriter, r *http.Request, _ []string) {
+	s.stream(w, r, func(t *gotwtr.Tweet) interface{} {
+		return &gotwtr.VolumeStreamsResponse{
+			Tweet:    t,
+			Includes: s.streamIncludes(r, t),
+		}
+	})
+}
+
+// volumeStreams10 serves every Tweet on both partitions, since the fake holds far fewer Tweets than a sample.
+func (s *Server) volumeStreams10(w http.ResponseWriter, r *http.Request, params []string) {
+	if p := r.URL.Query().Get("partition"); p != "1" && p != "2" {
+		writeProblem(w, http.StatusBadRequest, "Invalid Request", "The `partition` query parameter value ["+p+"] is not one of [1, 2]")
+		return
+	}
+	s.volumeStreams(w, r, params)
+}
+
+func (s *Server) streamIncludes(r *http.Request, t *gotwtr.Tweet) *gotwtr.TweetIncludes {
+	inc := s.tweetIncludes(r, []*gotwtr.Tweet{t})
+	if inc == nil {
+		return nil
+	}
+	return &gotwtr.TweetIncludes{
+		Users:  inc.Users,
+		Tweets: inc.Tweets,
+	}
+}
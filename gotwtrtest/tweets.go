@@ -0,0 +1,341 @@
+package gotwtrtest
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/sivchari/gotwtr"
+)
+
+func (s *Server) tweetRoutes(rs []*route) []*route {
+	// Search Tweets
+	rs = handle(rs, http.MethodGet, "/2/tweets/search/recent", s.searchTweets)
+	rs = handle(rs, http.MethodGet, "/2/tweets/search/all", s.searchTweets)
+	// Tweets lookup
+	rs = handle(rs, http.MethodGet, "/2/tweets", s.retrieveMultipleTweets)
+	rs = handle(rs, http.MethodGet, "/2/tweets/:", s.retrieveSingleTweet)
+	// Manage Tweets
+	rs = handle(rs, http.MethodPost, "/2/tweets", s.postTweet)
+	rs = handle(rs, http.MethodDelete, "/2/tweets/:", s.deleteTweet)
+	// Hide replies
+	rs = handle(rs, http.MethodPut, "/2/tweets/:/hidden", s.hideReplies)
+	// Timelines
+	rs = handle(rs, http.MethodGet, "/2/users/:/tweets", s.userTweetTimeline)
+	rs = handle(rs, http.MethodGet, "/2/users/:/mentions", s.userMentionTimeline)
+	// Likes
+	rs = handle(rs, http.MethodGet, "/2/tweets/:/liking_users", s.tweetUsers(&s.store.likes))
+	rs = handle(rs, http.MethodGet, "/2/users/:/liked_tweets", s.userTweets(&s.store.likes))
+	rs = handle(rs, http.MethodPost, "/2/users/:/likes", s.postUserTweet(&s.store.likes, "liked"))
+	rs = handle(rs, http.MethodDelete, "/2/users/:/likes/:", s.undoUserTweet(&s.store.likes, "liked"))
+	// Retweets
+	rs = handle(rs, http.MethodGet, "/2/tweets/:/retweeted_by", s.tweetUsers(&s.store.retweets))
+	rs = handle(rs, http.MethodPost, "/2/users/:/retweets", s.postUserTweet(&s.store.retweets, "retweeted"))
+	rs = handle(rs, http.MethodDelete, "/2/users/:/retweets/:", s.undoUserTweet(&s.store.retweets, "retweeted"))
+	// Bookmarks
+	rs = handle(rs, http.MethodGet, "/2/users/:/bookmarks", s.userTweets(&s.store.bookmarks))
+	rs = handle(rs, http.MethodPost, "/2/users/:/bookmarks", s.postUserTweet(&s.store.bookmarks, "bookmarked"))
+	rs = handle(rs, http.MethodDelete, "/2/users/:/bookmarks/:", s.undoUserTweet(&s.store.bookmarks, "bookmarks"))
+	return rs
+}
+
+// tweetIncludes returns the includes requested by the expansions query parameter. It must be called with the store locked.
+func (s *Server) tweetIncludes(r *http.Request, tweets []*gotwtr.Tweet) *includes {
+	var inc includes
+	seenUsers := map[string]bool{}
+	addUser := func(id string) {
+		if u, ok := s.store.users[id]; ok && !seenUsers[id] {
+			seenUsers[id] = true
+			inc.Users = append(inc.Users, u)
+		}
+	}
+	seenTweets := map[string]bool{}
+	for _, t := range tweets {
+		if hasExpansion(r, string(gotwtr.ExpansionAuthorID)) {
+			addUser(t.AuthorID)
+		}
+		if hasExpansion(r, string(gotwtr.ExpansionInReplyToUserID)) {
+			addUser(t.InReplyToUserID)
+		}
+		if hasExpansion(r, string(gotwtr.ExpansionReferencedTweetsID)) {
+			for _, ref := range t.ReferencedTweets {
+				if rt, ok := s.store.tweets[ref.ID]; ok && !seenTweets[ref.ID] {
+					seenTweets[ref.ID] = true
+					inc.Tweets = append(inc.Tweets, rt)
+				}
+			}
+		}
+	}
+	if len(inc.Users) == 0 && len(inc.Tweets) == 0 {
+		return nil
+	}
+	return &inc
+}
+
+func (s *Server) writeTweetPage(w http.ResponseWriter, r *http.Request, tweets []*gotwtr.Tweet, defaultMaxResults int) {
+	start, end, next := page(r, len(tweets), defaultMaxResults)
+	tweets = tweets[start:end]
+	m := &meta{
+		ResultCount: len(tweets),
+		NextToken:   next,
+	}
+	if len(tweets) > 0 {
+		m.NewestID = tweets[0].ID
+		m.OldestID = tweets[len(tweets)-1].ID
+	}
+	writeJSON(w, http.StatusOK, &envelope{
+		Data:     tweets,
+		Includes: s.tweetIncludes(r, tweets),
+		Meta:     m,
+	})
+}
+
+func (s *Server) retrieveMultipleTweets(w http.ResponseWriter, r *http.Request, _ []string) {
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	ids := splitIDs(r.URL.Query().Get("ids"))
+	if len(ids) == 0 {
+		writeProblem(w, http.StatusBadRequest, "Invalid Request", "The `ids` query parameter can not be empty")
+		return
+	}
+	tweets := s.store.tweetsByIDs(ids)
+	var errs []*gotwtr.APIResponseError
+	for _, id := range ids {
+		if _, ok := s.store.tweets[id]; !ok {
+			errs = append(errs, notFound("tweet", "ids", id))
+		}
+	}
+	writeJSON(w, http.StatusOK, &envelope{
+		Data:     tweets,
+		Includes: s.tweetIncludes(r, tweets),
+		Errors:   errs,
+	})
+}
+
+func (s *Server) retrieveSingleTweet(w http.ResponseWriter, r *http.Request, params []string) {
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	t, ok := s.store.tweets[params[0]]
+	if !ok {
+		writeJSON(w, http.StatusOK, &envelope{
+			Errors: []*gotwtr.APIResponseError{notFound("tweet", "id", params[0])},
+		})
+		return
+	}
+	writeJSON(w, http.StatusOK, &envelope{
+		Data:     t,
+		Includes: s.tweetIncludes(r, []*gotwtr.Tweet{t}),
+	})
+}
+
+func (s *Server) postTweet(w http.ResponseWriter, r *http.Request, _ []string) {
+	var body gotwtr.PostTweetOption
+	if !decodeBody(w, r, &body) {
+		return
+	}
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	if body.Text == "" && body.Media == nil && body.Poll == nil && body.QuoteTweetID == "" {
+		writeProblem(w, http.StatusBadRequest, "Invalid Request", "One or more parameters to your request was invalid.")
+		return
+	}
+	id := s.store.nextID()
+	t := &gotwtr.Tweet{
+		ID:             id,
+		Text:           body.Text,
+		EditHistoryIDs: []string{id},
+		AuthorID:       s.store.me,
+		ConversationID: id,
+		CreatedAt:      s.store.timestamp(),
+		Geo:            body.Geo,
+		ReplySettings:  body.ReplySettings,
+	}
+	if body.Reply != nil && body.Reply.InReplyToTweetID != "" {
+		parent, ok := s.store.tweets[body.Reply.InReplyToTweetID]
+		if !ok {
+			writeProblem(w, http.StatusBadRequest, "Invalid Request", "The Tweet you are replying to does not exist.")
+			return
+		}
+		t.ConversationID = parent.ConversationID
+		t.InReplyToUserID = parent.AuthorID
+		t.ReferencedTweets = append(t.ReferencedTweets, &gotwtr.TweetReferencedTweet{Type: "replied_to", ID: parent.ID})
+	}
+	if body.QuoteTweetID != "" {
+		t.ReferencedTweets = append(t.ReferencedTweets, &gotwtr.TweetReferencedTweet{Type: "quoted", ID: body.QuoteTweetID})
+	}
+	if body.Media != nil || body.Poll != nil {
+		t.Attachments = &gotwtr.TweetAttachment{}
+		if body.Media != nil {
+			t.Attachments.MediaKeys = body.Media.MediaIDs
+		}
+		if body.Poll != nil {
+			t.Attachments.PollIDs = []string{s.store.nextID()}
+		}
+	}
+	s.store.putTweet(t)
+	s.store.publish(t)
+	writeJSON(w, http.StatusCreated, &envelope{
+		Data: &gotwtr.PostTweetData{ID: t.ID, Text: t.Text},
+	})
+}
+
+func (s *Server) deleteTweet(w http.ResponseWriter, r *http.Request, params []string) {
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	writeJSON(w, http.StatusOK, &envelope{
+		Data: &gotwtr.DeleteTweetData{Deleted: s.store.deleteTweet(params[0])},
+	})
+}
+
+func (s *Server) hideReplies(w http.ResponseWriter, r *http.Request, params []string) {
+	var body struct {
+		Hidden bool `json:"hidden"`
+	}
+	if !decodeBody(w, r, &body) {
+		return
+	}
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	if _, ok := s.store.tweets[params[0]]; !ok {
+		writeProblem(w, http.StatusNotFound, "Not Found Error", "Could not find tweet with id: ["+params[0]+"].")
+		return
+	}
+	if body.Hidden {
+		s.store.hidden[params[0]] = true
+	} else {
+		delete(s.store.hidden, params[0])
+	}
+	writeJSON(w, http.StatusOK, &envelope{
+		Data: &gotwtr.HideRepliesResponseData{Hidden: body.Hidden},
+	})
+}
+
+// Hidden reports whether the reply with the given Tweet ID has been hidden.
+func (s *Server) Hidden(tweetID string) bool {
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	return s.store.hidden[tweetID]
+}
+
+func (s *Server) userTweetTimeline(w http.ResponseWriter, r *http.Request, params []string) {
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	tweets := s.store.recentTweets(func(t *gotwtr.Tweet) bool {
+		return t.AuthorID == params[0]
+	})
+	s.writeTweetPage(w, r, tweets, 10)
+}
+
+func (s *Server) userMentionTimeline(w http.ResponseWriter, r *http.Request, params []string) {
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	u, ok := s.store.users[params[0]]
+	if !ok {
+		writeJSON(w, http.StatusOK, &envelope{
+			Errors: []*gotwtr.APIResponseError{notFound("user", "id", params[0])},
+		})
+		return
+	}
+	mention := strings.ToLower("@" + u.UserName)
+	tweets := s.store.recentTweets(func(t *gotwtr.Tweet) bool {
+		return strings.Contains(strings.ToLower(t.Text), mention)
+	})
+	s.writeTweetPage(w, r, tweets, 10)
+}
+
+func (s *Server) searchTweets(w http.ResponseWriter, r *http.Request, _ []string) {
+	query := r.URL.Query().Get("query")
+	if query == "" {
+		writeProblem(w, http.StatusBadRequest, "Invalid Request", "The `query` query parameter can not be empty")
+		return
+	}
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	tweets := s.store.recentTweets(func(t *gotwtr.Tweet) bool {
+		return s.store.match(query, t)
+	})
+	s.writeTweetPage(w, r, tweets, 10)
+}
+
+// match reports whether t satisfies every term of query. It understands plain keywords,
+// negation and the from:, to:, conversation_id: and is:reply operators.
+func (s *store) match(query string, t *gotwtr.Tweet) bool {
+	for _, term := range strings.Fields(query) {
+		negate := strings.HasPrefix(term, "-")
+		term = strings.TrimPrefix(term, "-")
+		if s.matchTerm(term, t) == negate {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *store) matchTerm(term string, t *gotwtr.Tweet) bool {
+	op, value, ok := strings.Cut(term, ":")
+	if !ok {
+		return strings.Contains(strings.ToLower(t.Text), strings.ToLower(strings.Trim(term, `"`)))
+	}
+	switch op {
+	case "from":
+		u, ok := s.users[t.AuthorID]
+		return t.AuthorID == value || ok && strings.EqualFold(u.UserName, value)
+	case "to":
+		u, ok := s.users[t.InReplyToUserID]
+		return t.InReplyToUserID == value || ok && strings.EqualFold(u.UserName, value)
+	case "conversation_id":
+		return t.ConversationID == value
+	case "is":
+		return value == "reply" && t.InReplyToUserID != ""
+	default:
+		return strings.Contains(strings.ToLower(t.Text), strings.ToLower(term))
+	}
+}
+
+// tweetUsers serves the users related to the Tweet in the path through rel.
+func (s *Server) tweetUsers(rel *relation) handlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, params []string) {
+		s.store.mu.Lock()
+		defer s.store.mu.Unlock()
+		s.writeUserPage(w, r, s.store.usersByIDs(rel.sources(params[0])), 100)
+	}
+}
+
+// userTweets serves the Tweets related to the user in the path through rel.
+func (s *Server) userTweets(rel *relation) handlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, params []string) {
+		s.store.mu.Lock()
+		defer s.store.mu.Unlock()
+		s.writeTweetPage(w, r, s.store.tweetsByIDs(rel.targets(params[0])), 100)
+	}
+}
+
+func (s *Server) postUserTweet(rel *relation, key string) handlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, params []string) {
+		var body struct {
+			TweetID string `json:"tweet_id"`
+		}
+		if !decodeBody(w, r, &body) {
+			return
+		}
+		s.store.mu.Lock()
+		defer s.store.mu.Unlock()
+		if _, ok := s.store.tweets[body.TweetID]; !ok {
+			writeProblem(w, http.StatusBadRequest, "Invalid Request", "Could not find tweet with tweet_id: ["+body.TweetID+"].")
+			return
+		}
+		rel.add(params[0], body.TweetID)
+		writeJSON(w, http.StatusOK, &envelope{
+			Data: map[string]bool{key: true},
+		})
+	}
+}
+
+func (s *Server) undoUserTweet(rel *relation, key string) handlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, params []string) {
+		s.store.mu.Lock()
+		defer s.store.mu.Unlock()
+		rel.remove(params[0], params[1])
+		writeJSON(w, http.StatusOK, &envelope{
+			Data: map[string]bool{key: false},
+		})
+	}
+}
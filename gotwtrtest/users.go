@@ -0,0 +1,199 @@
+package gotwtrtest
+
+import (
+	"net/http"
+
+	"github.com/sivchari/gotwtr"
+)
+
+func (s *Server) userRoutes(rs []*route) []*route {
+	// Users lookup
+	rs = handle(rs, http.MethodGet, "/2/users/me", s.me)
+	rs = handle(rs, http.MethodGet, "/2/users", s.retrieveMultipleUsersWithIDs)
+	rs = handle(rs, http.MethodGet, "/2/users/by", s.retrieveMultipleUsersWithUserNames)
+	rs = handle(rs, http.MethodGet, "/2/users/by/username/:", s.retrieveSingleUserWithUserName)
+	rs = handle(rs, http.MethodGet, "/2/users/:", s.retrieveSingleUserWithID)
+	// Follows
+	rs = handle(rs, http.MethodGet, "/2/users/:/followers", s.relatedUsers(&s.store.following, true))
+	rs = handle(rs, http.MethodGet, "/2/users/:/following", s.relatedUsers(&s.store.following, false))
+	rs = handle(rs, http.MethodPost, "/2/users/:/following", s.postRelation(&s.store.following, "following"))
+	rs = handle(rs, http.MethodDelete, "/2/users/:/following/:", s.undoRelation(&s.store.following, "following"))
+	// Blocks
+	rs = handle(rs, http.MethodGet, "/2/users/:/blocking", s.relatedUsers(&s.store.blocking, false))
+	rs = handle(rs, http.MethodPost, "/2/users/:/blocking", s.postRelation(&s.store.blocking, "blocking"))
+	rs = handle(rs, http.MethodDelete, "/2/users/:/blocking/:", s.undoRelation(&s.store.blocking, "blocking"))
+	// Mutes
+	rs = handle(rs, http.MethodGet, "/2/users/:/muting", s.relatedUsers(&s.store.muting, false))
+	rs = handle(rs, http.MethodPost, "/2/users/:/muting", s.postRelation(&s.store.muting, "muting"))
+	rs = handle(rs, http.MethodDelete, "/2/users/:/muting/:", s.undoRelation(&s.store.muting, "muting"))
+	return rs
+}
+
+// userIncludes returns the includes requested by the expansions query parameter. It must be called with the store locked.
+func (s *Server) userIncludes(r *http.Request, users []*gotwtr.User) *includes {
+	if !hasExpansion(r, string(gotwtr.ExpansionPinnedTweetID)) {
+		return nil
+	}
+	var inc includes
+	for _, u := range users {
+		if t, ok := s.store.tweets[u.PinnedTweetID]; ok {
+			inc.Tweets = append(inc.Tweets, t)
+		}
+	}
+	if len(inc.Tweets) == 0 {
+		return nil
+	}
+	return &inc
+}
+
+func (s *Server) writeUserPage(w http.ResponseWriter, r *http.Request, users []*gotwtr.User, defaultMaxResults int) {
+	start, end, next := page(r, len(users), defaultMaxResults)
+	users = users[start:end]
+	writeJSON(w, http.StatusOK, &envelope{
+		Data:     users,
+		Includes: s.userIncludes(r, users),
+		Meta: &meta{
+			ResultCount: len(users),
+			NextToken:   next,
+		},
+	})
+}
+
+func (s *Server) me(w http.ResponseWriter, r *http.Request, _ []string) {
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	u, ok := s.store.users[s.store.me]
+	if !ok {
+		writeProblem(w, http.StatusForbidden, "Unsupported Authentication", "Authenticating with OAuth 2.0 Application-Only is forbidden for this endpoint.")
+		return
+	}
+	writeJSON(w, http.StatusOK, &envelope{
+		Data: u,
+	})
+}
+
+func (s *Server) retrieveMultipleUsersWithIDs(w http.ResponseWriter, r *http.Request, _ []string) {
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	ids := splitIDs(r.URL.Query().Get("ids"))
+	if len(ids) == 0 {
+		writeProblem(w, http.StatusBadRequest, "Invalid Request", "The `ids` query parameter can not be empty")
+		return
+	}
+	users := s.store.usersByIDs(ids)
+	var errs []*gotwtr.APIResponseError
+	for _, id := range ids {
+		if _, ok := s.store.users[id]; !ok {
+			errs = append(errs, notFound("user", "ids", id))
+		}
+	}
+	writeJSON(w, http.StatusOK, &envelope{
+		Data:     users,
+		Includes: s.userIncludes(r, users),
+		Errors:   errs,
+	})
+}
+
+func (s *Server) retrieveSingleUserWithID(w http.ResponseWriter, r *http.Request, params []string) {
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	u, ok := s.store.users[params[0]]
+	if !ok {
+		writeJSON(w, http.StatusOK, &envelope{
+			Errors: []*gotwtr.APIResponseError{notFound("user", "id", params[0])},
+		})
+		return
+	}
+	writeJSON(w, http.StatusOK, &envelope{
+		Data:     u,
+		Includes: s.userIncludes(r, []*gotwtr.User{u}),
+	})
+}
+
+func (s *Server) retrieveMultipleUsersWithUserNames(w http.ResponseWriter, r *http.Request, _ []string) {
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	names := splitIDs(r.URL.Query().Get("usernames"))
+	if len(names) == 0 {
+		writeProblem(w, http.StatusBadRequest, "Invalid Request", "The `usernames` query parameter can not be empty")
+		return
+	}
+	var (
+		users []*gotwtr.User
+		errs  []*gotwtr.APIResponseError
+	)
+	for _, name := range names {
+		if u := s.store.userByName(name); u != nil {
+			users = append(users, u)
+			continue
+		}
+		errs = append(errs, notFound("user", "usernames", name))
+	}
+	writeJSON(w, http.StatusOK, &envelope{
+		Data:     users,
+		Includes: s.userIncludes(r, users),
+		Errors:   errs,
+	})
+}
+
+func (s *Server) retrieveSingleUserWithUserName(w http.ResponseWriter, r *http.Request, params []string) {
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	u := s.store.userByName(params[0])
+	if u == nil {
+		writeJSON(w, http.StatusOK, &envelope{
+			Errors: []*gotwtr.APIResponseError{notFound("user", "username", params[0])},
+		})
+		return
+	}
+	writeJSON(w, http.StatusOK, &envelope{
+		Data:     u,
+		Includes: s.userIncludes(r, []*gotwtr.User{u}),
+	})
+}
+
+// relatedUsers serves the users related to the user in the path through rel.
+// When reverse is true the users pointing at the path user are served, e.g. followers instead of following.
+func (s *Server) relatedUsers(rel *relation, reverse bool) handlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, params []string) {
+		s.store.mu.Lock()
+		defer s.store.mu.Unlock()
+		ids := rel.targets(params[0])
+		if reverse {
+			ids = rel.sources(params[0])
+		}
+		s.writeUserPage(w, r, s.store.usersByIDs(ids), 100)
+	}
+}
+
+func (s *Server) postRelation(rel *relation, key string) handlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, params []string) {
+		var body struct {
+			TargetUserID string `json:"target_user_id"`
+		}
+		if !decodeBody(w, r, &body) {
+			return
+		}
+		s.store.mu.Lock()
+		defer s.store.mu.Unlock()
+		if _, ok := s.store.users[body.TargetUserID]; !ok {
+			writeProblem(w, http.StatusBadRequest, "Invalid Request", "Could not find user with target_user_id: ["+body.TargetUserID+"].")
+			return
+		}
+		rel.add(params[0], body.TargetUserID)
+		writeJSON(w, http.StatusOK, &envelope{
+			Data: map[string]bool{key: true},
+		})
+	}
+}
+
+func (s *Server) undoRelation(rel *relation, key string) handlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, params []string) {
+		s.store.mu.Lock()
+		defer s.store.mu.Unlock()
+		rel.remove(params[0], params[1])
+		writeJSON(w, http.StatusOK, &envelope{
+			Data: map[string]bool{key: false},
+		})
+	}
+}
@@ -13,7 +13,7 @@ func hideReplies(ctx context.Context, c *client, tweetID string, hidden bool) (*
 	if tweetID == "" {
 		return nil, errors.New("hide replies: tweetID parameter is required")
 	}
-	ep := fmt.Sprintf(hideRepliesURL, tweetID)
+	ep := c.baseURL + fmt.Sprintf(hideRepliesURL, tweetID)
 
 	body := &hideRepliesBody{
 		Hidden: hidden,
@@ -30,7 +30,7 @@ func hideReplies(ctx context.Context, c *client, tweetID string, hidden bool) (*
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.bearerToken))
 	req.Header.Set("Content-Type", "application/json")
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("hide replies: failed to send request: %w", err)
 	}
@@ -41,11 +41,7 @@ func hideReplies(ctx context.Context, c *client, tweetID string, hidden bool) (*
 		return nil, fmt.Errorf("hide replies: failed to decode response: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &hideReplies, &HTTPError{
-			APIName: "hide replies",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &hideReplies, newAPIError("hide replies", req, resp)
 	}
 	return &hideReplies, nil
 }
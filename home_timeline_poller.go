@@ -0,0 +1,113 @@
+package gotwtr
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/sivchari/gotwtr/snowflake"
+)
+
+// HomeTimelinePoller polls the home timeline of a user and returns only the Tweets posted since the previous poll.
+//
+//	p := client.HomeTimelinePoller(userID)
+//	for range time.Tick(time.Minute) {
+//		tweets, includes, err := p.Poll(ctx)
+//		...
+//	}
+type HomeTimelinePoller struct {
+	// MaxPages bounds the pages fetched by a poll, newest first. Zero fetches every new page.
+	// When there are more new pages, the next polls carry on with the older ones before
+	// fetching the Tweets posted in the meantime, so no Tweet is skipped.
+	// The first poll, which has no previous Tweet to start from, only fetches the newest page.
+	MaxPages int
+
+	c      *Client
+	userID string
+	opt    []*HomeTimelineOption
+
+	mu      sync.Mutex
+	sinceID string
+	// pendingToken is the token of the new pages which a poll left for the next one,
+	// and pendingNewestID the newest Tweet seen since sinceID.
+	pendingToken    string
+	pendingNewestID string
+}
+
+// HomeTimelinePoller returns a HomeTimelinePoller for the home timeline of userID.
+// The SinceID of opt resumes polling after a Tweet which was already seen; the pagination token of opt is ignored.
+func (c *Client) HomeTimelinePoller(userID string, opt ...*HomeTimelineOption) *HomeTimelinePoller {
+	p := &HomeTimelinePoller{
+		c:      c,
+		userID: userID,
+		opt:    opt,
+	}
+	if len(opt) == 1 {
+		p.sinceID = opt[0].SinceID
+	}
+	return p
+}
+
+// Poll returns the Tweets posted since the previous poll, newest first, and their includes.
+// A poll which carries on with the pages left by MaxPages returns Tweets older than those of the previous poll.
+// When it fails, the next poll starts again from the same Tweet, so no Tweet is missed.
+func (p *HomeTimelinePoller) Poll(ctx context.Context) ([]*Tweet, *TweetIncludes, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var o HomeTimelineOption
+	switch len(p.opt) {
+	case 0:
+		// do nothing
+	case 1:
+		o = *p.opt[0]
+	default:
+		return nil, nil, errors.New("home timeline poller: only one option is allowed")
+	}
+	o.PaginationToken = p.pendingToken
+	if p.sinceID != "" {
+		o.SinceID = p.sinceID
+		// since_id already bounds the timeline, a start time would hide Tweets posted between two polls.
+		o.StartTime = time.Time{}
+	}
+	pager := p.c.HomeTimelinePager(ctx, p.userID, &o)
+	pager.MaxPages = p.MaxPages
+	if p.sinceID == "" {
+		pager.MaxPages = 1
+	}
+
+	var tweets []*Tweet
+	for pager.Next() {
+		tweets = append(tweets, pager.Page()...)
+	}
+	if err := pager.Err(); err != nil {
+		return nil, nil, err
+	}
+	newest := p.pendingNewestID
+	for _, t := range tweets {
+		if snowflake.Newer(t.ID, newest) {
+			newest = t.ID
+		}
+	}
+	// since_id only moves once every page after it was returned, otherwise the pages left would be skipped.
+	if next := pager.NextToken(); next != "" && p.sinceID != "" {
+		p.pendingToken = next
+		p.pendingNewestID = newest
+	} else {
+		p.pendingToken = ""
+		p.pendingNewestID = ""
+		if snowflake.Newer(newest, p.sinceID) {
+			p.sinceID = newest
+		}
+	}
+	return tweets, pager.Includes(), nil
+}
+
+// SinceID returns the ID of the newest Tweet returned so far, or while the pages left by MaxPages are being fetched,
+// the ID the poll started from. It can be given back as the SinceID of HomeTimelineOption to resume polling after a restart.
+func (p *HomeTimelinePoller) SinceID() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.sinceID
+}
@@ -0,0 +1,125 @@
+package gotwtr_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sivchari/gotwtr"
+)
+
+func Test_HomeTimelinePoller(t *testing.T) {
+	t.Parallel()
+	fail := false
+	c := gotwtr.New("key", gotwtr.WithHTTPClient(mockHTTPClient(func(req *http.Request) *http.Response {
+		if fail {
+			return &http.Response{StatusCode: http.StatusServiceUnavailable, Body: io.NopCloser(strings.NewReader(`{}`))}
+		}
+		q := req.URL.Query()
+		var body string
+		switch q.Get("since_id") + "/" + q.Get("pagination_token") {
+		case "/":
+			body = `{"data": [{"id": "9", "text": "nine"}, {"id": "8", "text": "eight"}], "meta": {"result_count": 2, "next_token": "older"}}`
+		case "9/":
+			body = `{"data": [{"id": "11", "text": "eleven", "author_id": "1"}], "includes": {"users": [{"id": "1", "username": "a"}]}, "meta": {"result_count": 1, "next_token": "p2"}}`
+		case "9/p2":
+			body = `{"data": [{"id": "10", "text": "ten", "author_id": "1"}], "includes": {"users": [{"id": "1", "username": "a"}]}, "meta": {"result_count": 1}}`
+		case "11/":
+			body = `{"meta": {"result_count": 0}}`
+		default:
+			t.Errorf("unexpected query %s", req.URL.RawQuery)
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body))}
+	})))
+	ctx := context.Background()
+	p := c.HomeTimelinePoller("2244994945")
+
+	ids := func(tweets []*gotwtr.Tweet) []string {
+		var ids []string
+		for _, t := range tweets {
+			ids = append(ids, t.ID)
+		}
+		return ids
+	}
+	polls := []struct {
+		fail      bool
+		want      []string
+		wantUsers int
+		wantErr   bool
+		wantSince string
+	}{
+		// the first poll only fetches the newest page.
+		{want: []string{"9", "8"}, wantSince: "9"},
+		{want: []string{"11", "10"}, wantUsers: 1, wantSince: "11"},
+		{fail: true, wantErr: true, wantSince: "11"},
+		{want: nil, wantSince: "11"},
+	}
+	for i, poll := range polls {
+		fail = poll.fail
+		tweets, includes, err := p.Poll(ctx)
+		if (err != nil) != poll.wantErr {
+			t.Fatalf("poll %d: Poll() error = %v, wantErr %v", i, err, poll.wantErr)
+		}
+		if diff := cmp.Diff(poll.want, ids(tweets)); diff != "" {
+			t.Errorf("poll %d: Poll() mismatch (-want +got):\n%s", i, diff)
+		}
+		if includes != nil && len(includes.Users) != poll.wantUsers {
+			t.Errorf("poll %d: Poll() includes %d users, want %d", i, len(includes.Users), poll.wantUsers)
+		}
+		if got := p.SinceID(); got != poll.wantSince {
+			t.Errorf("poll %d: SinceID() = %s, want %s", i, got, poll.wantSince)
+		}
+	}
+}
+
+func Test_HomeTimelinePollerMaxPages(t *testing.T) {
+	t.Parallel()
+	c := gotwtr.New("key", gotwtr.WithHTTPClient(mockHTTPClient(func(req *http.Request) *http.Response {
+		q := req.URL.Query()
+		var body string
+		switch q.Get("since_id") + "/" + q.Get("pagination_token") {
+		case "9/":
+			body = `{"data": [{"id": "12", "text": "twelve"}, {"id": "11", "text": "eleven"}], "meta": {"result_count": 2, "next_token": "p2"}}`
+		case "9/p2":
+			body = `{"data": [{"id": "10", "text": "ten"}], "meta": {"result_count": 1}}`
+		case "12/":
+			body = `{"data": [{"id": "13", "text": "thirteen"}], "meta": {"result_count": 1}}`
+		default:
+			t.Errorf("unexpected query %s", req.URL.RawQuery)
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body))}
+	})))
+	ctx := context.Background()
+	p := c.HomeTimelinePoller("2244994945", &gotwtr.HomeTimelineOption{SinceID: "9"})
+	p.MaxPages = 1
+
+	polls := []struct {
+		want      []string
+		wantSince string
+	}{
+		// the second page is left for the next poll, so since_id stays where it was.
+		{want: []string{"12", "11"}, wantSince: "9"},
+		{want: []string{"10"}, wantSince: "12"},
+		{want: []string{"13"}, wantSince: "13"},
+	}
+	for i, poll := range polls {
+		tweets, _, err := p.Poll(ctx)
+		if err != nil {
+			t.Fatalf("poll %d: Poll() error = %v", i, err)
+		}
+		var ids []string
+		for _, t := range tweets {
+			ids = append(ids, t.ID)
+		}
+		if diff := cmp.Diff(poll.want, ids); diff != "" {
+			t.Errorf("poll %d: Poll() mismatch (-want +got):\n%s", i, diff)
+		}
+		if got := p.SinceID(); got != poll.wantSince {
+			t.Errorf("poll %d: SinceID() = %s, want %s", i, got, poll.wantSince)
+		}
+	}
+}
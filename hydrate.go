@@ -0,0 +1,229 @@
+package gotwtr
+
+import "strings"
+
+// HydratedTweet is a Tweet with the objects it references resolved from the includes of its response.
+// A field is nil or empty when the Tweet has no such reference, or when the reference is dangling.
+type HydratedTweet struct {
+	*Tweet
+	Author           *User
+	InReplyToUser    *User
+	Media            []*Media
+	Polls            []*Poll
+	Place            *Place
+	ReferencedTweets []*HydratedReferencedTweet
+	MentionedUsers   []*User
+}
+
+// HydratedReferencedTweet is a referenced Tweet, e.g. a quoted one, resolved recursively.
+type HydratedReferencedTweet struct {
+	Type string
+	ID   string
+	// Tweet is nil when the referenced Tweet is not in the includes.
+	Tweet *HydratedTweet
+}
+
+// DanglingReference is a reference of a Tweet which is not in the includes, either because
+// its Expansion was not requested or because the object is not available, e.g. a deleted Tweet.
+type DanglingReference struct {
+	TweetID   string
+	Expansion Expansion
+	// ID is the ID, media key or username which was not found.
+	ID string
+}
+
+// TweetBearer is a response which carries Tweets and their includes, like TweetsResponse or SearchTweetsResponse.
+type TweetBearer interface {
+	tweetsWithIncludes() ([]*Tweet, *TweetIncludes)
+}
+
+// Hydrate resolves the references of the Tweets of resp from its includes.
+func Hydrate(resp TweetBearer) ([]*HydratedTweet, []*DanglingReference) {
+	tweets, includes := resp.tweetsWithIncludes()
+	return HydrateTweets(tweets, includes)
+}
+
+// HydrateTweets resolves the references of tweets from includes, which may be nil, e.g. those accumulated by a Pager.
+// Referenced Tweets are resolved recursively, and the dangling references of every Tweet reached are reported.
+func HydrateTweets(tweets []*Tweet, includes *TweetIncludes) ([]*HydratedTweet, []*DanglingReference) {
+	r := newIncludesResolver(includes)
+	hydrated := make([]*HydratedTweet, 0, len(tweets))
+	for _, t := range tweets {
+		if t == nil {
+			continue
+		}
+		hydrated = append(hydrated, r.hydrate(t))
+	}
+	return hydrated, r.dangling
+}
+
+type includesResolver struct {
+	users     map[string]*User
+	userNames map[string]*User
+	media     map[string]*Media
+	polls     map[string]*Poll
+	places    map[string]*Place
+	tweets    map[string]*Tweet
+	hydrated  map[string]*HydratedTweet
+	dangling  []*DanglingReference
+}
+
+func newIncludesResolver(includes *TweetIncludes) *includesResolver {
+	r := &includesResolver{
+		users:     make(map[string]*User),
+		userNames: make(map[string]*User),
+		media:     make(map[string]*Media),
+		polls:     make(map[string]*Poll),
+		places:    make(map[string]*Place),
+		tweets:    make(map[string]*Tweet),
+		hydrated:  make(map[string]*HydratedTweet),
+	}
+	if includes == nil {
+		return r
+	}
+	for _, u := range includes.Users {
+		r.users[u.ID] = u
+		// usernames are case insensitive, and mentions keep the case typed in the Tweet.
+		r.userNames[strings.ToLower(u.UserName)] = u
+	}
+	for _, m := range includes.Media {
+		r.media[m.MediaKey] = m
+	}
+	for _, p := range includes.Polls {
+		r.polls[p.ID] = p
+	}
+	for _, p := range includes.Places {
+		r.places[p.ID] = p
+	}
+	for _, t := range includes.Tweets {
+		r.tweets[t.ID] = t
+	}
+	return r
+}
+
+func (r *includesResolver) dangle(t *Tweet, expansion Expansion, id string) {
+	r.dangling = append(r.dangling, &DanglingReference{
+		TweetID:   t.ID,
+		Expansion: expansion,
+		ID:        id,
+	})
+}
+
+func (r *includesResolver) hydrate(t *Tweet) *HydratedTweet {
+	if h, ok := r.hydrated[t.ID]; ok {
+		return h
+	}
+	h := &HydratedTweet{Tweet: t}
+	// registered before the referenced Tweets are resolved, so that a cycle ends here.
+	r.hydrated[t.ID] = h
+
+	if t.AuthorID != "" {
+		if h.Author = r.users[t.AuthorID]; h.Author == nil {
+			r.dangle(t, ExpansionAuthorID, t.AuthorID)
+		}
+	}
+	if t.InReplyToUserID != "" {
+		if h.InReplyToUser = r.users[t.InReplyToUserID]; h.InReplyToUser == nil {
+			r.dangle(t, ExpansionInReplyToUserID, t.InReplyToUserID)
+		}
+	}
+	if t.Attachments != nil {
+		for _, key := range t.Attachments.MediaKeys {
+			if m, ok := r.media[key]; ok {
+				h.Media = append(h.Media, m)
+			} else {
+				r.dangle(t, ExpansionAttachmentsMediaKeys, key)
+			}
+		}
+		for _, id := range t.Attachments.PollIDs {
+			if p, ok := r.polls[id]; ok {
+				h.Polls = append(h.Polls, p)
+			} else {
+				r.dangle(t, ExpansionAttachmentsPollIDs, id)
+			}
+		}
+	}
+	if t.Geo != nil && t.Geo.PlaceID != "" {
+		if h.Place = r.places[t.Geo.PlaceID]; h.Place == nil {
+			r.dangle(t, ExpansionGeoPlaceID, t.Geo.PlaceID)
+		}
+	}
+	if t.Entities != nil {
+		for _, m := range t.Entities.Mentions {
+			u := r.users[m.ID]
+			if u == nil {
+				u = r.userNames[strings.ToLower(m.UserName)]
+			}
+			if u == nil {
+				r.dangle(t, ExpansionEntitiesMentionsUserName, m.UserName)
+				continue
+			}
+			h.MentionedUsers = append(h.MentionedUsers, u)
+		}
+	}
+	for _, ref := range t.ReferencedTweets {
+		hr := &HydratedReferencedTweet{
+			Type: ref.Type,
+			ID:   ref.ID,
+		}
+		if rt, ok := r.tweets[ref.ID]; ok {
+			hr.Tweet = r.hydrate(rt)
+		} else {
+			r.dangle(t, ExpansionReferencedTweetsID, ref.ID)
+		}
+		h.ReferencedTweets = append(h.ReferencedTweets, hr)
+	}
+	return h
+}
+
+func (r *TweetsResponse) tweetsWithIncludes() ([]*Tweet, *TweetIncludes) {
+	return r.Tweets, r.Includes
+}
+
+func (r *TweetResponse) tweetsWithIncludes() ([]*Tweet, *TweetIncludes) {
+	return []*Tweet{r.Tweet}, r.Includes
+}
+
+func (r *UserTweetTimelineResponse) tweetsWithIncludes() ([]*Tweet, *TweetIncludes) {
+	return r.Tweets, r.Includes
+}
+
+func (r *HomeTimelineResponse) tweetsWithIncludes() ([]*Tweet, *TweetIncludes) {
+	return r.Tweets, r.Includes
+}
+
+func (r *UserMentionTimelineResponse) tweetsWithIncludes() ([]*Tweet, *TweetIncludes) {
+	return r.Tweets, r.Includes
+}
+
+func (r *SearchTweetsResponse) tweetsWithIncludes() ([]*Tweet, *TweetIncludes) {
+	return r.Tweets, r.Includes
+}
+
+func (r *QuoteTweetsResponse) tweetsWithIncludes() ([]*Tweet, *TweetIncludes) {
+	return r.Tweets, r.Includes
+}
+
+func (r *LookupUserBookmarksResponse) tweetsWithIncludes() ([]*Tweet, *TweetIncludes) {
+	return r.Tweets, r.Includes
+}
+
+func (r *TweetsUserLikedResponse) tweetsWithIncludes() ([]*Tweet, *TweetIncludes) {
+	return r.Tweets, r.Includes
+}
+
+func (r *ListTweetsResponse) tweetsWithIncludes() ([]*Tweet, *TweetIncludes) {
+	return r.Tweets, r.Includes
+}
+
+func (r *SpaceTweetsResponse) tweetsWithIncludes() ([]*Tweet, *TweetIncludes) {
+	return r.Tweets, r.Includes
+}
+
+func (r *ConnectToStreamResponse) tweetsWithIncludes() ([]*Tweet, *TweetIncludes) {
+	return []*Tweet{r.Tweet}, r.Includes
+}
+
+func (r *VolumeStreamsResponse) tweetsWithIncludes() ([]*Tweet, *TweetIncludes) {
+	return []*Tweet{r.Tweet}, r.Includes
+}
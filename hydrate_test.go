@@ -0,0 +1,101 @@
+package gotwtr_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sivchari/gotwtr"
+)
+
+func Test_Hydrate(t *testing.T) {
+	t.Parallel()
+	dev := &gotwtr.User{ID: "2244994945", Name: "Twitter Dev", UserName: "TwitterDev"}
+	api := &gotwtr.User{ID: "6253282", Name: "Twitter API", UserName: "TwitterAPI"}
+	photo := &gotwtr.Media{MediaKey: "3_1", Type: "photo"}
+	poll := &gotwtr.Poll{ID: "1199786642468413448"}
+	poll2 := &gotwtr.Poll{ID: "1199786642468413449"}
+	place := &gotwtr.Place{ID: "01a9a39529b27f36", FullName: "Manhattan, NY"}
+	quoted := &gotwtr.Tweet{
+		ID:               "1",
+		Text:             "the original",
+		AuthorID:         api.ID,
+		ReferencedTweets: []*gotwtr.TweetReferencedTweet{{Type: "replied_to", ID: "0"}},
+	}
+	resp := &gotwtr.TweetsResponse{
+		Tweets: []*gotwtr.Tweet{
+			{
+				ID:              "2",
+				Text:            "@twitterapi @gone look",
+				AuthorID:        dev.ID,
+				InReplyToUserID: api.ID,
+				Attachments: &gotwtr.TweetAttachment{
+					MediaKeys: []string{"3_1", "3_2"},
+					PollIDs:   []string{poll.ID, poll2.ID},
+				},
+				Geo: &gotwtr.TweetGeo{PlaceID: place.ID},
+				Entities: &gotwtr.TweetEntity{
+					Mentions: []*gotwtr.TweetMention{
+						{Start: 0, End: 11, UserName: "twitterapi"},
+						{Start: 12, End: 17, UserName: "gone"},
+					},
+				},
+				ReferencedTweets: []*gotwtr.TweetReferencedTweet{{Type: "quoted", ID: quoted.ID}},
+			},
+		},
+		Includes: &gotwtr.TweetIncludes{
+			Media:  []*gotwtr.Media{photo},
+			Places: []*gotwtr.Place{place},
+			Polls:  []*gotwtr.Poll{poll, poll2},
+			Tweets: []*gotwtr.Tweet{quoted},
+			Users:  []*gotwtr.User{dev, api},
+		},
+	}
+
+	tweets, dangling := gotwtr.Hydrate(resp)
+	if len(tweets) != 1 {
+		t.Fatalf("Hydrate() returned %d tweets, want 1", len(tweets))
+	}
+	got := tweets[0]
+	if got.Tweet != resp.Tweets[0] || got.Author != dev || got.InReplyToUser != api || got.Place != place {
+		t.Errorf("Hydrate() = %+v, want its author, reply user, poll and place resolved", got)
+	}
+	if diff := cmp.Diff([]*gotwtr.Poll{poll, poll2}, got.Polls); diff != "" {
+		t.Errorf("Hydrate() polls mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]*gotwtr.Media{photo}, got.Media); diff != "" {
+		t.Errorf("Hydrate() media mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]*gotwtr.User{api}, got.MentionedUsers); diff != "" {
+		t.Errorf("Hydrate() mentioned users mismatch (-want +got):\n%s", diff)
+	}
+	if len(got.ReferencedTweets) != 1 || got.ReferencedTweets[0].Tweet == nil {
+		t.Fatalf("Hydrate() referenced tweets = %+v, want the quoted tweet", got.ReferencedTweets)
+	}
+	q := got.ReferencedTweets[0].Tweet
+	if q.Tweet != quoted || q.Author != api || q.ReferencedTweets[0].Tweet != nil {
+		t.Errorf("Hydrate() quoted tweet = %+v, want it resolved recursively", q)
+	}
+
+	want := []*gotwtr.DanglingReference{
+		{TweetID: "2", Expansion: gotwtr.ExpansionAttachmentsMediaKeys, ID: "3_2"},
+		{TweetID: "2", Expansion: gotwtr.ExpansionEntitiesMentionsUserName, ID: "gone"},
+		{TweetID: "1", Expansion: gotwtr.ExpansionReferencedTweetsID, ID: "0"},
+	}
+	if diff := cmp.Diff(want, dangling); diff != "" {
+		t.Errorf("Hydrate() dangling mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func Test_HydrateTweetsWithoutIncludes(t *testing.T) {
+	t.Parallel()
+	tweets, dangling := gotwtr.HydrateTweets([]*gotwtr.Tweet{{ID: "1", AuthorID: "2"}}, nil)
+	if len(tweets) != 1 || tweets[0].Author != nil {
+		t.Fatalf("HydrateTweets() = %+v, want one tweet without author", tweets)
+	}
+	want := []*gotwtr.DanglingReference{
+		{TweetID: "1", Expansion: gotwtr.ExpansionAuthorID, ID: "2"},
+	}
+	if diff := cmp.Diff(want, dangling); diff != "" {
+		t.Errorf("HydrateTweets() dangling mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -13,7 +13,7 @@ func usersLikingTweet(ctx context.Context, c *client, tweetID string, opt ...*Us
 	if tweetID == "" {
 		return nil, errors.New("users liking tweet: tweet id parameter is required")
 	}
-	ep := fmt.Sprintf(usersLikingTweetURL, tweetID)
+	ep := c.baseURL + fmt.Sprintf(usersLikingTweetURL, tweetID)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ep, nil)
 	if err != nil {
@@ -32,7 +32,7 @@ func usersLikingTweet(ctx context.Context, c *client, tweetID string, opt ...*Us
 	}
 	uopt.addQuery(req)
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("users liking tweet: %w", err)
 	}
@@ -43,11 +43,7 @@ func usersLikingTweet(ctx context.Context, c *client, tweetID string, opt ...*Us
 		return nil, fmt.Errorf("users liking tweet: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &ultr, &HTTPError{
-			APIName: "users liking tweet",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &ultr, newAPIError("users liking tweet", req, resp)
 	}
 
 	return &ultr, nil
@@ -57,7 +53,7 @@ func tweetsUserLiked(ctx context.Context, c *client, userID string, opt ...*Twee
 	if userID == "" {
 		return nil, errors.New("tweets user liked: user id parameter is required")
 	}
-	ep := fmt.Sprintf(tweetsUserLikedURL, userID)
+	ep := c.baseURL + fmt.Sprintf(tweetsUserLikedURL, userID)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ep, nil)
 	if err != nil {
@@ -87,7 +83,7 @@ func tweetsUserLiked(ctx context.Context, c *client, userID string, opt ...*Twee
 	}
 	topt.addQuery(req)
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("tweets user liked: %w", err)
 	}
@@ -98,11 +94,7 @@ func tweetsUserLiked(ctx context.Context, c *client, userID string, opt ...*Twee
 		return nil, fmt.Errorf("tweets user liked: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &tulr, &HTTPError{
-			APIName: "tweets user liked",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &tulr, newAPIError("tweets user liked", req, resp)
 	}
 
 	return &tulr, nil
@@ -112,7 +104,7 @@ func postUsersLikingTweet(ctx context.Context, c *client, userID string, tweetID
 	if userID == "" {
 		return nil, errors.New("post users liking tweet: userID parameter is required")
 	}
-	ep := fmt.Sprintf(postUsersLikingTweetURL, userID)
+	ep := c.baseURL + fmt.Sprintf(postUsersLikingTweetURL, userID)
 
 	if tweetID == "" {
 		return nil, errors.New("post users liking tweet: tweetID parameter is required")
@@ -132,7 +124,7 @@ func postUsersLikingTweet(ctx context.Context, c *client, userID string, tweetID
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.bearerToken))
 	req.Header.Set("Content-Type", "application/json")
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("post users liking tweet response: %w", err)
 	}
@@ -143,11 +135,7 @@ func postUsersLikingTweet(ctx context.Context, c *client, userID string, tweetID
 		return nil, fmt.Errorf("post users liking tweet decode: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &postUsersLikingTweet, &HTTPError{
-			APIName: "post users liking tweet",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &postUsersLikingTweet, newAPIError("post users liking tweet", req, resp)
 	}
 
 	return &postUsersLikingTweet, nil
@@ -160,7 +148,7 @@ func undoUsersLikingTweet(ctx context.Context, c *client, userID string, tweetID
 	if tweetID == "" {
 		return nil, errors.New("undo users liking tweet: tweetID parameter is required")
 	}
-	ep := fmt.Sprintf(undoUsersLikingTweetURL, userID, tweetID)
+	ep := c.baseURL + fmt.Sprintf(undoUsersLikingTweetURL, userID, tweetID)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, ep, nil)
 	if err != nil {
@@ -168,7 +156,7 @@ func undoUsersLikingTweet(ctx context.Context, c *client, userID string, tweetID
 	}
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.bearerToken))
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("undo users liking tweet response: %w", err)
 	}
@@ -179,11 +167,7 @@ func undoUsersLikingTweet(ctx context.Context, c *client, userID string, tweetID
 		return nil, fmt.Errorf("undo users liking tweet decode: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &undoUsersLikingTweet, &HTTPError{
-			APIName: "undo users liking tweet",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &undoUsersLikingTweet, newAPIError("undo users liking tweet", req, resp)
 	}
 
 	return &undoUsersLikingTweet, nil
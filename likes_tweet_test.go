@@ -156,7 +156,7 @@ func Test_usersLikingTweet(t *testing.T) {
 				Users: []*gotwtr.LookUpUsersWhoLiked{
 					{
 						ID:            "1065249714214457345",
-						CreatedAt:     "2018-11-21T14:24:58.000Z",
+						CreatedAt:     mustParseTime("2018-11-21T14:24:58.000Z"),
 						Name:          "Spaces",
 						PinnedTweetID: "1389270063807598594",
 						Description:   "Twitter Spaces is where live audio conversations happen.",
@@ -164,21 +164,21 @@ func Test_usersLikingTweet(t *testing.T) {
 					},
 					{
 						ID:          "783214",
-						CreatedAt:   "2007-02-20T14:35:54.000Z",
+						CreatedAt:   mustParseTime("2007-02-20T14:35:54.000Z"),
 						Name:        "Twitter",
 						Description: "What's happening?!",
 						UserName:    "Twitter",
 					},
 					{
 						ID:          "1526228120",
-						CreatedAt:   "2013-06-17T23:57:45.000Z",
+						CreatedAt:   mustParseTime("2013-06-17T23:57:45.000Z"),
 						Name:        "Twitter Data",
 						Description: "Data-driven insights about notable moments and conversations from Twitter, Inc., plus tips and tricks to help you get the most out of Twitter data.",
 						UserName:    "TwitterData",
 					},
 					{
 						ID:            "2244994945",
-						CreatedAt:     "2013-12-14T04:35:55.000Z",
+						CreatedAt:     mustParseTime("2013-12-14T04:35:55.000Z"),
 						Name:          "Twitter Dev",
 						PinnedTweetID: "1354143047324299264",
 						Description:   "The voice of the #TwitterDev team and your official source for updates, news, and events, related to the #TwitterAPI.",
@@ -186,7 +186,7 @@ func Test_usersLikingTweet(t *testing.T) {
 					},
 					{
 						ID:            "6253282",
-						CreatedAt:     "2007-05-23T06:01:13.000Z",
+						CreatedAt:     mustParseTime("2007-05-23T06:01:13.000Z"),
 						Name:          "Twitter API",
 						PinnedTweetID: "1293595870563381249",
 						Description:   "Tweets about changes and service issues. Follow @TwitterDev for more.",
@@ -359,31 +359,31 @@ func Test_tweetsUserLiked(t *testing.T) {
 			want: &gotwtr.TweetsUserLikedResponse{
 				Tweets: []*gotwtr.TweetsUserLiked{
 					{
-						CreatedAt: "2021-02-18T17:12:47.000Z",
+						CreatedAt: mustParseTime("2021-02-18T17:12:47.000Z"),
 						Source:    "Twitter Web App",
 						ID:        "1362449997430542337",
 						Text:      "Honored to be the first developer to be featured in @TwitterDev's love fest 🥰♥️😍 https://t.co/g8TsPoZsij",
 					},
 					{
-						CreatedAt: "2021-02-26T21:38:43.000Z",
+						CreatedAt: mustParseTime("2021-02-26T21:38:43.000Z"),
 						Source:    "Twitter Web App",
 						ID:        "1365416026435854338",
 						Text:      "We're so happy for our Official Partner @Brandwatch and their big news. https://t.co/3DwWBNSq0o https://t.co/bDUGbgPkKO",
 					},
 					{
-						CreatedAt: "2020-08-20T16:41:00.000Z",
+						CreatedAt: mustParseTime("2020-08-20T16:41:00.000Z"),
 						Source:    "Twitter Web App",
 						ID:        "1296487407475462144",
 						Text:      "Check out this feature on @TwitterDev to learn more about how we're mining social media data to make sense of this evolving #publichealth crisis https://t.co/sIFLXRSvEX.",
 					},
 					{
-						CreatedAt: "2020-08-14T18:55:42.000Z",
+						CreatedAt: mustParseTime("2020-08-14T18:55:42.000Z"),
 						Source:    "Twitter for Android",
 						ID:        "1294346980072624128",
 						Text:      "I awake from five years of slumber https://t.co/OEPVyAFcfB",
 					},
 					{
-						CreatedAt: "2020-07-14T21:38:10.000Z",
+						CreatedAt: mustParseTime("2020-07-14T21:38:10.000Z"),
 						Source:    "Twitter for  iPhone",
 						ID:        "1283153843367206912",
 						Text:      "@wongmjane Wish we could tell you more, but I’m only a teapot 👀",
@@ -619,3 +619,75 @@ func Test_undoUsersLikingTweet(t *testing.T) {
 		})
 	}
 }
+
+func Test_tweetsUserLikedSharesTweet(t *testing.T) {
+	t.Parallel()
+	body := `{
+		"data": [
+			{
+				"id": "1362449997430542337",
+				"text": "Honored to be featured by @TwitterDev",
+				"author_id": "2244994945",
+				"edit_history_tweet_ids": ["1362449997430542337"],
+				"entities": {
+					"mentions": [{"start": 26, "end": 37, "username": "TwitterDev", "id": "2244994945"}]
+				},
+				"withheld": {"copyright": false, "country_codes": ["DE"], "scope": "tweet"},
+				"edit_controls": {"edits_remaining": 5, "is_edit_eligible": true, "editable_until": "2021-02-18T17:42:47.000Z"}
+			}
+		],
+		"includes": {
+			"users": [{"id": "2244994945", "name": "Twitter Dev", "username": "TwitterDev"}]
+		},
+		"meta": {"result_count": 1}
+	}`
+	c := gotwtr.New("key", gotwtr.WithHTTPClient(mockHTTPClient(func(request *http.Request) *http.Response {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+		}
+	})))
+	got, err := c.TweetsUserLiked(context.Background(), "user_id")
+	if err != nil {
+		t.Fatalf("client.TweetsUserLiked() error = %v", err)
+	}
+	author := &gotwtr.User{ID: "2244994945", Name: "Twitter Dev", UserName: "TwitterDev"}
+	want := &gotwtr.TweetsUserLikedResponse{
+		Tweets: []*gotwtr.Tweet{
+			{
+				ID:             "1362449997430542337",
+				Text:           "Honored to be featured by @TwitterDev",
+				AuthorID:       "2244994945",
+				EditHistoryIDs: []string{"1362449997430542337"},
+				Entities: &gotwtr.TweetEntity{
+					Mentions: []*gotwtr.TweetMention{{Start: 26, End: 37, UserName: "TwitterDev", ID: "2244994945"}},
+				},
+				Withheld: &gotwtr.TweetWithheld{CountryCodes: []string{"DE"}, Scope: "tweet"},
+				EditControls: &gotwtr.TweetEditControls{
+					EditsRemaining: 5,
+					IsEditEligible: true,
+					EditableUntil:  mustParseTime("2021-02-18T17:42:47.000Z"),
+				},
+			},
+		},
+		Includes: &gotwtr.TweetIncludes{Users: []*gotwtr.User{author}},
+		Meta:     &gotwtr.TweetsUserLikedMeta{ResultCount: 1},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("client.TweetsUserLiked() mismatch (-want +got):\n%s", diff)
+	}
+
+	hydrated, dangling := gotwtr.Hydrate(got)
+	if len(dangling) != 0 {
+		t.Errorf("Hydrate() dangling = %+v, want none", dangling)
+	}
+	if len(hydrated) != 1 {
+		t.Fatalf("Hydrate() = %+v, want one tweet", hydrated)
+	}
+	if diff := cmp.Diff(author, hydrated[0].Author); diff != "" {
+		t.Errorf("Hydrate() author mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]*gotwtr.User{author}, hydrated[0].MentionedUsers); diff != "" {
+		t.Errorf("Hydrate() mentioned users mismatch (-want +got):\n%s", diff)
+	}
+}
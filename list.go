@@ -14,7 +14,7 @@ const (
 type List struct {
 	ID            string `json:"id"`
 	Name          string `json:"name"`
-	CreatedAt     string `json:"created_at,omitempty"`
+	CreatedAt     Time   `json:"created_at,omitempty"`
 	Private       bool   `json:"private,omitempty"`
 	FollowerCount int    `json:"follower_count,omitempty"`
 	MemberCount   int    `json:"member_count,omitempty"`
@@ -22,10 +22,9 @@ type List struct {
 	Description   string `json:"description,omitempty"`
 }
 
-type ListIncludes struct {
-	Tweets []*Tweet
-	Users  []*User
-}
+// ListIncludes is the includes of the List endpoints. It is TweetIncludes, so that the includes
+// of LookUpListTweets carry the media, places and polls of its Tweets.
+type ListIncludes = TweetIncludes
 
 type ListMeta struct {
 	ResultCount   int    `json:"result_count"`
@@ -54,7 +53,7 @@ type ListResponse struct {
 
 type ListTweetsResponse struct {
 	Tweets   []*Tweet            `json:"data"`
-	Includes *ListIncludes       `json:"includes,omitempty"`
+	Includes *TweetIncludes      `json:"includes,omitempty"`
 	Errors   []*APIResponseError `json:"errors,omitempty"`
 	Meta     *ListMeta           `json:"meta"`
 	Title    string              `json:"title,omitempty"`
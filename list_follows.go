@@ -13,7 +13,7 @@ func listFollowers(ctx context.Context, c *client, listID string, opt ...*ListFo
 	if listID == "" {
 		return nil, errors.New("list followers: listID parameter is required")
 	}
-	ep := fmt.Sprintf(listFollowersURL, listID)
+	ep := c.baseURL + fmt.Sprintf(listFollowersURL, listID)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ep, nil)
 	if err != nil {
@@ -44,7 +44,7 @@ func listFollowers(ctx context.Context, c *client, listID string, opt ...*ListFo
 	}
 	lopt.addQuery(req)
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("list followers: %w", err)
 	}
@@ -55,11 +55,7 @@ func listFollowers(ctx context.Context, c *client, listID string, opt ...*ListFo
 		return nil, fmt.Errorf("list followers: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &lfr, &HTTPError{
-			APIName: "list followers",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &lfr, newAPIError("list followers", req, resp)
 	}
 
 	return &lfr, nil
@@ -69,7 +65,7 @@ func allListsUserFollows(ctx context.Context, c *client, userID string, opt ...*
 	if userID == "" {
 		return nil, errors.New("all lists user follows: userID parameter is required")
 	}
-	ep := fmt.Sprintf(allListsUserFollowsURL, userID)
+	ep := c.baseURL + fmt.Sprintf(allListsUserFollowsURL, userID)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ep, nil)
 	if err != nil {
@@ -99,7 +95,7 @@ func allListsUserFollows(ctx context.Context, c *client, userID string, opt ...*
 	}
 	lopt.addQuery(req)
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("all lists user follows: %w", err)
 	}
@@ -110,11 +106,7 @@ func allListsUserFollows(ctx context.Context, c *client, userID string, opt ...*
 		return nil, fmt.Errorf("all lists user follows: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &alufr, &HTTPError{
-			APIName: "all lists user follows",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &alufr, newAPIError("all lists user follows", req, resp)
 	}
 
 	return &alufr, nil
@@ -124,7 +116,7 @@ func postListFollows(ctx context.Context, c *client, listID string, userID strin
 	if userID == "" {
 		return nil, errors.New("post list follows: userID parameter is required")
 	}
-	ep := fmt.Sprintf(postListFollowsURL, listID)
+	ep := c.baseURL + fmt.Sprintf(postListFollowsURL, userID)
 
 	if listID == "" {
 		return nil, errors.New("post list follows: listID parameter is required")
@@ -144,7 +136,7 @@ func postListFollows(ctx context.Context, c *client, listID string, userID strin
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.bearerToken))
 	req.Header.Set("Content-Type", "application/json")
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("post list follows response: %w", err)
 	}
@@ -155,11 +147,7 @@ func postListFollows(ctx context.Context, c *client, listID string, userID strin
 		return nil, fmt.Errorf("post list follows decode: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &postListFollows, &HTTPError{
-			APIName: "post list follows",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &postListFollows, newAPIError("post list follows", req, resp)
 	}
 
 	return &postListFollows, nil
@@ -172,7 +160,7 @@ func undoListFollows(ctx context.Context, c *client, listID string, userID strin
 	if userID == "" {
 		return nil, errors.New("undo list follows: userID parameter is required")
 	}
-	ep := fmt.Sprintf(undoListFollowsURL, userID, listID)
+	ep := c.baseURL + fmt.Sprintf(undoListFollowsURL, userID, listID)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, ep, nil)
 	if err != nil {
@@ -181,7 +169,7 @@ func undoListFollows(ctx context.Context, c *client, listID string, userID strin
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.bearerToken))
 	req.Header.Set("Content-Type", "application/json")
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("undo list follows response: %w", err)
 	}
@@ -192,11 +180,7 @@ func undoListFollows(ctx context.Context, c *client, listID string, userID strin
 		return nil, fmt.Errorf("undo list follows decode: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &undoListFollows, &HTTPError{
-			APIName: "undo list follows",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &undoListFollows, newAPIError("undo list follows", req, resp)
 	}
 
 	return &undoListFollows, nil
@@ -193,45 +193,45 @@ func Test_listFollowers(t *testing.T) {
 					{
 						UserName:  "alanbenlee",
 						Name:      "Alan Lee",
-						CreatedAt: "2020-11-06T22:56:55.000Z",
+						CreatedAt: mustParseTime("2020-11-06T22:56:55.000Z"),
 						ID:        "1324848235714736129",
 					},
 					{
 						PinnedTweetID: "1452599033625657359",
 						UserName:      "xo_chong",
 						Name:          "Wilson Chong",
-						CreatedAt:     "2020-11-16T15:31:22.000Z",
+						CreatedAt:     mustParseTime("2020-11-16T15:31:22.000Z"),
 						ID:            "1328359963937259520",
 					},
 					{
 						UserName:  "SumiraNazir",
 						Name:      "Sumira Nazir",
-						CreatedAt: "2021-10-22T18:02:31.000Z",
+						CreatedAt: mustParseTime("2021-10-22T18:02:31.000Z"),
 						ID:        "1451609880113070085",
 					},
 					{
 						PinnedTweetID: "1442182396523257861",
 						UserName:      "JustBorek",
 						Name:          "Bořek Šindelka(he/him)",
-						CreatedAt:     "2021-07-27T16:16:23.000Z",
+						CreatedAt:     mustParseTime("2021-07-27T16:16:23.000Z"),
 						ID:            "1420055293082415107",
 					},
 					{
 						UserName:  "w22ZccksRpafZAx",
 						Name:      "金井憲司",
-						CreatedAt: "2021-06-27T13:09:10.000Z",
+						CreatedAt: mustParseTime("2021-06-27T13:09:10.000Z"),
 						ID:        "1409136449803325441",
 					},
 				},
 				Includes: &gotwtr.ListIncludes{
 					Tweets: []*gotwtr.Tweet{
 						{
-							CreatedAt: "2021-10-25T11:32:52.000Z",
+							CreatedAt: mustParseTime("2021-10-25T11:32:52.000Z"),
 							ID:        "1452599033625657359",
 							Text:      "https://t.co/aEuBQLXeuL",
 						},
 						{
-							CreatedAt: "2021-09-26T17:40:52.000Z",
+							CreatedAt: mustParseTime("2021-09-26T17:40:52.000Z"),
 							ID:        "1442182396523257861",
 							Text:      "Yes couple of days back nI want to kill my self I'm still here because of some amazing people please share this is important to talk about #mentalhealth @JustBorek #wheelchair #DisabilityTwitter #MedTwitter @heatherpsyd @Tweetinggoddess @NashaterS @msfatale @castleDD https://t.co/9hkSPV9NB1",
 						},
@@ -423,7 +423,7 @@ func Test_allListsUserFollows(t *testing.T) {
 						{
 							UserName:  "alanbenlee",
 							ID:        "1324848235714736129",
-							CreatedAt: "2009-08-28T18:30:45.000Z",
+							CreatedAt: mustParseTime("2009-08-28T18:30:45.000Z"),
 							Name:      "Alan Lee",
 						},
 					},
@@ -12,7 +12,7 @@ func lookUpList(ctx context.Context, c *client, listID string, opt ...*LookUpLis
 	if listID == "" {
 		return nil, errors.New("look up list: listID parameter is required")
 	}
-	ep := fmt.Sprintf(lookUpListURL, listID)
+	ep := c.baseURL + fmt.Sprintf(lookUpListURL, listID)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ep, nil)
 	if err != nil {
@@ -31,7 +31,7 @@ func lookUpList(ctx context.Context, c *client, listID string, opt ...*LookUpLis
 	}
 	lopt.addQuery(req)
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("look up list response: %w", err)
 	}
@@ -42,11 +42,7 @@ func lookUpList(ctx context.Context, c *client, listID string, opt ...*LookUpLis
 		return nil, fmt.Errorf("look up list decode: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &lr, &HTTPError{
-			APIName: "look up list",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &lr, newAPIError("look up list", req, resp)
 	}
 
 	return &lr, nil
@@ -56,7 +52,7 @@ func lookUpAllListsOwned(ctx context.Context, c *client, userID string, opt ...*
 	if userID == "" {
 		return nil, errors.New("look up all lists owned: userID parameter is required")
 	}
-	ep := fmt.Sprintf(lookUpAllListsOwnedURL, userID)
+	ep := c.baseURL + fmt.Sprintf(lookUpAllListsOwnedURL, userID)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ep, nil)
 	if err != nil {
@@ -87,7 +83,7 @@ func lookUpAllListsOwned(ctx context.Context, c *client, userID string, opt ...*
 	}
 	aopt.addQuery(req)
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("look up all lists owned response: %w", err)
 	}
@@ -99,11 +95,7 @@ func lookUpAllListsOwned(ctx context.Context, c *client, userID string, opt ...*
 		return nil, fmt.Errorf("look up all lists owned decode: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &alor, &HTTPError{
-			APIName: "look up all lists owned",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &alor, newAPIError("look up all lists owned", req, resp)
 	}
 
 	return &alor, nil
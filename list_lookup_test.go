@@ -124,7 +124,7 @@ func Test_lookUpAllListsOwned(t *testing.T) {
 						{
 							UserName:  "TwitterDev",
 							ID:        "2244994945",
-							CreatedAt: "2013-12-14T04:35:55.000Z",
+							CreatedAt: mustParseTime("2013-12-14T04:35:55.000Z"),
 							Name:      "Twitter Dev",
 						},
 					},
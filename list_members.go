@@ -13,7 +13,7 @@ func listMembers(ctx context.Context, c *client, listID string, opt ...*ListMemb
 	if listID == "" {
 		return nil, errors.New("look up list members: id parameter is required")
 	}
-	lm := fmt.Sprintf(listMembersURL, listID)
+	lm := c.baseURL + fmt.Sprintf(listMembersURL, listID)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, lm, nil)
 	if err != nil {
@@ -44,7 +44,7 @@ func listMembers(ctx context.Context, c *client, listID string, opt ...*ListMemb
 	}
 	lopt.addQuery(req)
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("look up list members: %w", err)
 	}
@@ -56,11 +56,7 @@ func listMembers(ctx context.Context, c *client, listID string, opt ...*ListMemb
 		return nil, fmt.Errorf("look up list members: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &lmr, &HTTPError{
-			APIName: "owned lists lookup by id",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &lmr, newAPIError("owned lists lookup by id", req, resp)
 	}
 
 	return &lmr, nil
@@ -71,7 +67,7 @@ func listsSpecifiedUser(ctx context.Context, c *client, userID string, opt ...*L
 		return nil, errors.New("lists specified user: userID parameter is required")
 	}
 
-	lm := fmt.Sprintf(listsSpecifiedUserURL, userID)
+	lm := c.baseURL + fmt.Sprintf(listsSpecifiedUserURL, userID)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, lm, nil)
 	if err != nil {
@@ -102,7 +98,7 @@ func listsSpecifiedUser(ctx context.Context, c *client, userID string, opt ...*L
 	}
 	lopt.addQuery(req)
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("lists specified user: %w", err)
 	}
@@ -114,11 +110,7 @@ func listsSpecifiedUser(ctx context.Context, c *client, userID string, opt ...*L
 		return nil, fmt.Errorf("lists specified user: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &lmr, &HTTPError{
-			APIName: "lists specified user",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &lmr, newAPIError("lists specified user", req, resp)
 	}
 
 	return &lmr, nil
@@ -128,7 +120,7 @@ func postListMembers(ctx context.Context, c *client, listID string, userID strin
 	if listID == "" {
 		return nil, errors.New("post list members: listID parameter is required")
 	}
-	ep := fmt.Sprintf(postListMembersURL, listID)
+	ep := c.baseURL + fmt.Sprintf(postListMembersURL, listID)
 
 	if userID == "" {
 		return nil, errors.New("post list members: userID parameter is required")
@@ -148,7 +140,7 @@ func postListMembers(ctx context.Context, c *client, listID string, userID strin
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.bearerToken))
 	req.Header.Set("Content-Type", "application/json")
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("post list members response: %w", err)
 	}
@@ -159,11 +151,7 @@ func postListMembers(ctx context.Context, c *client, listID string, userID strin
 		return nil, fmt.Errorf("post list members decode: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &postListMembers, &HTTPError{
-			APIName: "post list members",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &postListMembers, newAPIError("post list members", req, resp)
 	}
 
 	return &postListMembers, nil
@@ -176,7 +164,7 @@ func undoListMembers(ctx context.Context, c *client, listID string, userID strin
 	if userID == "" {
 		return nil, errors.New("undo list members: userID parameter is required")
 	}
-	ep := fmt.Sprintf(undoListMembersURL, listID, userID)
+	ep := c.baseURL + fmt.Sprintf(undoListMembersURL, listID, userID)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, ep, nil)
 	if err != nil {
@@ -184,7 +172,7 @@ func undoListMembers(ctx context.Context, c *client, listID string, userID strin
 	}
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.bearerToken))
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("undo list members response: %w", err)
 	}
@@ -195,11 +183,7 @@ func undoListMembers(ctx context.Context, c *client, listID string, userID strin
 		return nil, fmt.Errorf("undo list members decode: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &undoListMembers, &HTTPError{
-			APIName: "undo list members",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &undoListMembers, newAPIError("undo list members", req, resp)
 	}
 
 	return &undoListMembers, nil
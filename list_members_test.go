@@ -243,7 +243,7 @@ func Test_ListsSpecifiedUser(t *testing.T) {
 					Users: []*gotwtr.User{
 						{
 							Name:      "구돆",
-							CreatedAt: "2020-02-11T12:52:11.000Z",
+							CreatedAt: mustParseTime("2020-02-11T12:52:11.000Z"),
 							ID:        "1227213680120479745",
 							UserName:  "Follow__Y0U",
 						},
@@ -13,7 +13,7 @@ func pinnedLists(ctx context.Context, c *client, userID string, opt ...*PinnedLi
 	if userID == "" {
 		return nil, errors.New("pinned lists: userID parameter is required")
 	}
-	ep := fmt.Sprintf(pinnedListsURL, userID)
+	ep := c.baseURL + fmt.Sprintf(pinnedListsURL, userID)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ep, nil)
 	if err != nil {
@@ -32,7 +32,7 @@ func pinnedLists(ctx context.Context, c *client, userID string, opt ...*PinnedLi
 	}
 	lopt.addQuery(req)
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("pinned lists response: %w", err)
 	}
@@ -43,11 +43,7 @@ func pinnedLists(ctx context.Context, c *client, userID string, opt ...*PinnedLi
 		return nil, fmt.Errorf("pinned lists decode: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &plr, &HTTPError{
-			APIName: "pinned lists",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &plr, newAPIError("pinned lists", req, resp)
 	}
 
 	return &plr, nil
@@ -57,7 +53,7 @@ func postPinnedLists(ctx context.Context, c *client, listID string, userID strin
 	if userID == "" {
 		return nil, errors.New("post pinned lists: userID parameter is required")
 	}
-	ep := fmt.Sprintf(postPinnedListsURL, userID)
+	ep := c.baseURL + fmt.Sprintf(postPinnedListsURL, userID)
 
 	if listID == "" {
 		return nil, errors.New("post pinned lists: listID parameter is required")
@@ -77,7 +73,7 @@ func postPinnedLists(ctx context.Context, c *client, listID string, userID strin
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.bearerToken))
 	req.Header.Set("Content-Type", "application/json")
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("post pinned lists response: %w", err)
 	}
@@ -88,11 +84,7 @@ func postPinnedLists(ctx context.Context, c *client, listID string, userID strin
 		return nil, fmt.Errorf("post pinned lists decode: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &ppl, &HTTPError{
-			APIName: "post pinned lists",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &ppl, newAPIError("post pinned lists", req, resp)
 	}
 	return &ppl, nil
 }
@@ -104,7 +96,7 @@ func undoPinnedLists(ctx context.Context, c *client, listID string, userID strin
 	if userID == "" {
 		return nil, errors.New("undo pinned lists: userID parameter is required")
 	}
-	ep := fmt.Sprintf(undoPinnedListsURL, userID, listID)
+	ep := c.baseURL + fmt.Sprintf(undoPinnedListsURL, userID, listID)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, ep, nil)
 	if err != nil {
@@ -112,7 +104,7 @@ func undoPinnedLists(ctx context.Context, c *client, listID string, userID strin
 	}
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.bearerToken))
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("undo pinned lists response: %w", err)
 	}
@@ -123,11 +115,7 @@ func undoPinnedLists(ctx context.Context, c *client, listID string, userID strin
 		return nil, fmt.Errorf("undo pinned lists decode: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &upl, &HTTPError{
-			APIName: "undo pinned lists",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &upl, newAPIError("undo pinned lists", req, resp)
 	}
 	return &upl, nil
 }
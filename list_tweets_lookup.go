@@ -12,7 +12,7 @@ func lookUpListTweets(ctx context.Context, c *client, listID string, opt ...*Lis
 	if listID == "" {
 		return nil, errors.New("look up list tweets: listID parameter is required")
 	}
-	ep := fmt.Sprintf(lookUpListTweetsURL, listID)
+	ep := c.baseURL + fmt.Sprintf(lookUpListTweetsURL, listID)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ep, nil)
 	if err != nil {
@@ -43,7 +43,7 @@ func lookUpListTweets(ctx context.Context, c *client, listID string, opt ...*Lis
 	}
 	lopt.addQuery(req)
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("look up list tweets: %w", err)
 	}
@@ -54,11 +54,7 @@ func lookUpListTweets(ctx context.Context, c *client, listID string, opt ...*Lis
 		return nil, fmt.Errorf("look up list tweets: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &ltr, &HTTPError{
-			APIName: "look up list tweets",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &ltr, newAPIError("look up list tweets", req, resp)
 	}
 
 	return &ltr, nil
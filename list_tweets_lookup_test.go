@@ -114,7 +114,7 @@ func Test_lookUpListTweets(t *testing.T) {
 				Tweets: []*gotwtr.Tweet{
 					{
 						AuthorID:  "2244994945",
-						CreatedAt: "2018-11-26T16:37:10.000Z",
+						CreatedAt: mustParseTime("2018-11-26T16:37:10.000Z"),
 						Text:      "Just getting started with Twitter APIs? Find out what you need in order to build an app. Watch this video! https://t.co/Hg8nkfoizN",
 						ID:        "1067094924124872705",
 					},
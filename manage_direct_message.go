@@ -13,7 +13,7 @@ func createOneToOneDM(ctx context.Context, c *client, participantID string, body
 	if participantID == "" {
 		return nil, errors.New("create a one to one DM: participant id parameter is required")
 	}
-	ep := fmt.Sprintf(createOneToOneDMURL, participantID)
+	ep := c.baseURL + fmt.Sprintf(createOneToOneDMURL, participantID)
 	j, err := json.Marshal(body)
 	if err != nil {
 		return nil, errors.New("create a one to one DM: can not marshal")
@@ -26,7 +26,7 @@ func createOneToOneDM(ctx context.Context, c *client, participantID string, body
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.bearerToken))
 	req.Header.Set("Content-Type", "application/json")
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("create a one to one DM response: %w", err)
 	}
@@ -37,11 +37,7 @@ func createOneToOneDM(ctx context.Context, c *client, participantID string, body
 		return nil, fmt.Errorf("create a one to one DM decode: %w", err)
 	}
 	if resp.StatusCode != http.StatusCreated {
-		return &createOneToOneDM, &HTTPError{
-			APIName: "create one to one DM",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &createOneToOneDM, newAPIError("create one to one DM", req, resp)
 	}
 	return &createOneToOneDM, nil
 }
@@ -50,7 +46,7 @@ func createNewGroupDM(ctx context.Context, c *client, conversationID string, bod
 	if conversationID == "" {
 		return nil, errors.New("create new group DM: conversation id parameter is required")
 	}
-	ep := fmt.Sprintf(createNewGroupDMURL, conversationID)
+	ep := c.baseURL + fmt.Sprintf(createNewGroupDMURL, conversationID)
 	j, err := json.Marshal(body)
 	if err != nil {
 		return nil, errors.New("create new group DM: can not marshal")
@@ -63,7 +59,7 @@ func createNewGroupDM(ctx context.Context, c *client, conversationID string, bod
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.bearerToken))
 	req.Header.Set("Content-Type", "application/json")
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("create new group DM response: %w", err)
 	}
@@ -74,11 +70,7 @@ func createNewGroupDM(ctx context.Context, c *client, conversationID string, bod
 		return nil, fmt.Errorf("create new group DM decode: %w", err)
 	}
 	if resp.StatusCode != http.StatusCreated {
-		return &createNewGroupDM, &HTTPError{
-			APIName: "create new group DM",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &createNewGroupDM, newAPIError("create new group DM", req, resp)
 	}
 	return &createNewGroupDM, nil
 }
@@ -89,14 +81,14 @@ func postDM(ctx context.Context, c *client, body *PostDMBody) (*PostDMResponse,
 		return nil, errors.New("post DM: can not marshal")
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, postDMURL, bytes.NewBuffer((j)))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+postDMURL, bytes.NewBuffer((j)))
 	if err != nil {
 		return nil, fmt.Errorf("post DM with ctx: %w", err)
 	}
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.bearerToken))
 	req.Header.Set("Content-Type", "application/json")
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("post DM response: %w", err)
 	}
@@ -107,11 +99,7 @@ func postDM(ctx context.Context, c *client, body *PostDMBody) (*PostDMResponse,
 		return nil, fmt.Errorf("post DM decode: %w", err)
 	}
 	if resp.StatusCode != http.StatusCreated {
-		return &postDM, &HTTPError{
-			APIName: "post DM",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &postDM, newAPIError("post DM", req, resp)
 	}
 	return &postDM, nil
 }
@@ -285,3 +285,30 @@ func Test_PostDM(t *testing.T) {
 		})
 	}
 }
+
+func Test_PostDMOmitsCreatedAt(t *testing.T) {
+	t.Parallel()
+	var sent string
+	c := gotwtr.New("key", gotwtr.WithHTTPClient(mockHTTPClient(func(req *http.Request) *http.Response {
+		b, err := io.ReadAll(req.Body)
+		if err != nil {
+			t.Fatalf("io.ReadAll() error = %v", err)
+		}
+		sent = string(b)
+		return &http.Response{
+			StatusCode: http.StatusCreated,
+			Body:       io.NopCloser(strings.NewReader(string(postDM))),
+		}
+	})))
+	_, err := c.PostDM(context.Background(), &gotwtr.PostDMBody{
+		ConversationType: "Group",
+		ParticipantIDs:   []string{"944480690", "906948460078698496"},
+		Message:          &gotwtr.DirectMessage{Text: "Hello to you two"},
+	})
+	if err != nil {
+		t.Fatalf("client.PostDM() error = %v", err)
+	}
+	if strings.Contains(sent, "created_at") {
+		t.Errorf("request body = %s, want no created_at", sent)
+	}
+}
@@ -21,14 +21,14 @@ func createNewList(ctx context.Context, c *client, body *CreateNewListBody) (*Cr
 		return nil, errors.New("create new list : can not marshal")
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, createNewListURL, bytes.NewBuffer(j))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+createNewListURL, bytes.NewBuffer(j))
 	if err != nil {
 		return nil, fmt.Errorf("create new list new request with ctx: %w", err)
 	}
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.bearerToken))
 	req.Header.Set("Content-Type", "application/json")
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("create new list response: %w", err)
 	}
@@ -39,11 +39,7 @@ func createNewList(ctx context.Context, c *client, body *CreateNewListBody) (*Cr
 		return nil, fmt.Errorf("create new list decode: %w", err)
 	}
 	if resp.StatusCode != http.StatusCreated {
-		return &createNewList, &HTTPError{
-			APIName: "create new list",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &createNewList, newAPIError("create new list", req, resp)
 	}
 
 	return &createNewList, nil
@@ -53,7 +49,7 @@ func deleteList(ctx context.Context, c *client, listID string) (*DeleteListRespo
 	if listID == "" {
 		return nil, errors.New("delete list: list id parameter is required")
 	}
-	ep := fmt.Sprintf(deleteListURL, listID)
+	ep := c.baseURL + fmt.Sprintf(deleteListURL, listID)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, ep, nil)
 	if err != nil {
@@ -61,7 +57,7 @@ func deleteList(ctx context.Context, c *client, listID string) (*DeleteListRespo
 	}
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.bearerToken))
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("delete list response: %w", err)
 	}
@@ -72,11 +68,7 @@ func deleteList(ctx context.Context, c *client, listID string) (*DeleteListRespo
 		return nil, fmt.Errorf("delete list decode: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &deleteList, &HTTPError{
-			APIName: "delete list",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &deleteList, newAPIError("delete list", req, resp)
 	}
 
 	return &deleteList, nil
@@ -86,7 +78,7 @@ func updateMetaDataForList(ctx context.Context, c *client, listID string, body .
 	if listID == "" {
 		return nil, errors.New("update meta data for list: list id parameter is required")
 	}
-	ep := fmt.Sprintf(updateMetaDataForListURL, listID)
+	ep := c.baseURL + fmt.Sprintf(updateMetaDataForListURL, listID)
 
 	var ubody UpdateMetaDataForListBody
 	switch len(body) {
@@ -109,7 +101,7 @@ func updateMetaDataForList(ctx context.Context, c *client, listID string, body .
 	}
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.bearerToken))
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("update meta data for list response: %w", err)
 	}
@@ -120,11 +112,7 @@ func updateMetaDataForList(ctx context.Context, c *client, listID string, body .
 		return nil, fmt.Errorf("update meta data for list decode: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &updateMetaDataForList, &HTTPError{
-			APIName: "update meta data for list",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &updateMetaDataForList, newAPIError("update meta data for list", req, resp)
 	}
 
 	return &updateMetaDataForList, nil
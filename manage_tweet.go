@@ -15,13 +15,13 @@ func postTweet(ctx context.Context, c *client, body *PostTweetOption) (*PostTwee
 		return nil, fmt.Errorf("postTweet json marshal: %w", err)
 	}
 	fmt.Println(string(j))
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, postTweetURL, bytes.NewBuffer(j))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+postTweetURL, bytes.NewBuffer(j))
 	if err != nil {
 		return nil, fmt.Errorf("postTweet new request with ctx: %w", err)
 	}
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.bearerToken))
 	req.Header.Set("Content-Type", "application/json")
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("post tweet response: %w", err)
 	}
@@ -32,11 +32,7 @@ func postTweet(ctx context.Context, c *client, body *PostTweetOption) (*PostTwee
 		return nil, fmt.Errorf("post tweet decode: %w", err)
 	}
 	if resp.StatusCode != http.StatusCreated {
-		return &postTweet, &HTTPError{
-			APIName: "post tweet",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &postTweet, newAPIError("post tweet", req, resp)
 	}
 	return &postTweet, nil
 }
@@ -45,7 +41,7 @@ func deleteTweet(ctx context.Context, c *client, tweetID string) (*DeleteTweetRe
 	if tweetID == "" {
 		return nil, errors.New("delete tweet: tweet id parameter is required")
 	}
-	ep := fmt.Sprintf(deleteTweetURL, tweetID)
+	ep := c.baseURL + fmt.Sprintf(deleteTweetURL, tweetID)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, ep, nil)
 	if err != nil {
@@ -53,7 +49,7 @@ func deleteTweet(ctx context.Context, c *client, tweetID string) (*DeleteTweetRe
 	}
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.bearerToken))
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("delete tweet response: %w", err)
 	}
@@ -64,11 +60,7 @@ func deleteTweet(ctx context.Context, c *client, tweetID string) (*DeleteTweetRe
 		return nil, fmt.Errorf("delete tweet decode: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &deleteTweet, &HTTPError{
-			APIName: "delete tweet",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &deleteTweet, newAPIError("delete tweet", req, resp)
 	}
 	return &deleteTweet, nil
 }
@@ -9,7 +9,7 @@ import (
 )
 
 func me(ctx context.Context, c *client, opt ...*MeOption) (*MeResponse, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, meURL, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+meURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("me new request with ctx: %w", err)
 	}
@@ -26,7 +26,7 @@ func me(ctx context.Context, c *client, opt ...*MeOption) (*MeResponse, error) {
 	}
 	mopt.addQuery(req)
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("me response: %w", err)
 	}
@@ -37,11 +37,7 @@ func me(ctx context.Context, c *client, opt ...*MeOption) (*MeResponse, error) {
 		return nil, fmt.Errorf("me decode: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &me, &HTTPError{
-			APIName: "me",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &me, newAPIError("me", req, resp)
 	}
 	return &me, nil
 }
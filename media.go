@@ -63,3 +63,62 @@ func mediaFieldsToString(mfs []MediaField) []string {
 	}
 	return slice
 }
+
+// MediaCategory tells Twitter how uploaded media is going to be used.
+type MediaCategory string
+
+const (
+	MediaCategoryTweetImage MediaCategory = "tweet_image"
+	MediaCategoryTweetGIF   MediaCategory = "tweet_gif"
+	MediaCategoryTweetVideo MediaCategory = "tweet_video"
+	MediaCategoryDMImage    MediaCategory = "dm_image"
+	MediaCategoryDMGIF      MediaCategory = "dm_gif"
+	MediaCategoryDMVideo    MediaCategory = "dm_video"
+	MediaCategorySubtitles  MediaCategory = "subtitles"
+)
+
+// States of MediaProcessingInfo.
+const (
+	MediaProcessingPending    = "pending"
+	MediaProcessingInProgress = "in_progress"
+	MediaProcessingFailed     = "failed"
+	MediaProcessingSucceeded  = "succeeded"
+)
+
+// MediaUploadResponse is returned by the INIT, FINALIZE and STATUS commands of the media upload.
+// MediaIDString is the ID to use in PostTweetOption.Media or DirectMessageAttachment.
+type MediaUploadResponse struct {
+	MediaID          int64                `json:"media_id"`
+	MediaIDString    string               `json:"media_id_string"`
+	MediaKey         string               `json:"media_key,omitempty"`
+	Size             int64                `json:"size,omitempty"`
+	ExpiresAfterSecs int                  `json:"expires_after_secs,omitempty"`
+	ProcessingInfo   *MediaProcessingInfo `json:"processing_info,omitempty"`
+	Errors           []*APIResponseError  `json:"errors,omitempty"`
+}
+
+// MediaProcessingInfo is the state of the asynchronous processing of videos and GIFs.
+type MediaProcessingInfo struct {
+	State           string                `json:"state"`
+	CheckAfterSecs  int                   `json:"check_after_secs,omitempty"`
+	ProgressPercent int                   `json:"progress_percent,omitempty"`
+	Error           *MediaProcessingError `json:"error,omitempty"`
+}
+
+// MediaProcessingError is returned when Twitter failed to process uploaded media.
+type MediaProcessingError struct {
+	Code    int    `json:"code"`
+	Name    string `json:"name"`
+	Message string `json:"message"`
+}
+
+func (e *MediaProcessingError) Error() string {
+	return "media processing failed: " + e.Name + ": " + e.Message
+}
+
+// MediaSubtitle attaches uploaded subtitles to a video.
+type MediaSubtitle struct {
+	MediaID      string `json:"media_id"`
+	LanguageCode string `json:"language_code"`
+	DisplayName  string `json:"display_name"`
+}
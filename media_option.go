@@ -0,0 +1,35 @@
+package gotwtr
+
+import (
+	"net/url"
+	"strings"
+)
+
+type InitMediaUploadOption struct {
+	MediaCategory    MediaCategory
+	AdditionalOwners []string // user IDs which can also use the media
+}
+
+func (m *InitMediaUploadOption) addForm(form url.Values) {
+	if m.MediaCategory != "" {
+		form.Add("media_category", string(m.MediaCategory))
+	}
+	if len(m.AdditionalOwners) > 0 {
+		form.Add("additional_owners", strings.Join(m.AdditionalOwners, ","))
+	}
+}
+
+type UploadMediaOption struct {
+	// MediaCategory defaults to tweet_gif for image/gif, tweet_video for videos, subtitles for text files,
+	// e.g. text/srt subtitles, and tweet_image otherwise.
+	MediaCategory    MediaCategory
+	AdditionalOwners []string
+	// ChunkSize is the size of each APPEND command. Zero means 4MB, the maximum is 5MB.
+	ChunkSize int
+	// AltText is set once the media is processed.
+	AltText string
+	// Subtitles are attached once the video is processed.
+	Subtitles []*MediaSubtitle
+	// OnProgress is called after every uploaded chunk.
+	OnProgress func(sent, total int64)
+}
@@ -0,0 +1,328 @@
+package gotwtr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultMediaChunkSize = 4 << 20
+	maxMediaChunkSize     = 5 << 20
+	defaultMediaCheckWait = time.Second
+)
+
+// isSuccess reports whether the media upload endpoints, which answer with 200, 201, 202 or 204 depending on the command, succeeded.
+func isSuccess(resp *http.Response) bool {
+	return resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices
+}
+
+// mediaUploadCommand sends a command of the media upload endpoint, in the body for POST and in the query for GET.
+func mediaUploadCommand(ctx context.Context, c *client, apiName, method string, form url.Values) (*MediaUploadResponse, error) {
+	ep := c.uploadURL + mediaUploadURL
+	var body io.Reader
+	if method == http.MethodGet {
+		ep += "?" + form.Encode()
+	} else {
+		body = strings.NewReader(form.Encode())
+	}
+	req, err := http.NewRequestWithContext(ctx, method, ep, body)
+	if err != nil {
+		return nil, fmt.Errorf("%s new request with ctx: %w", apiName, err)
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.bearerToken))
+	if body != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", apiName, err)
+	}
+	defer resp.Body.Close()
+
+	var m MediaUploadResponse
+	if err := json.NewDecoder(resp.Body).Decode(&m); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("%s decode: %w", apiName, err)
+	}
+	if !isSuccess(resp) {
+		return &m, newAPIError(apiName, req, resp)
+	}
+	return &m, nil
+}
+
+func initMediaUpload(ctx context.Context, c *client, mediaType string, totalBytes int64, opt ...*InitMediaUploadOption) (*MediaUploadResponse, error) {
+	switch {
+	case mediaType == "":
+		return nil, errors.New("init media upload: media type parameter is required")
+	case totalBytes <= 0:
+		return nil, errors.New("init media upload: total bytes must be greater than zero")
+	default:
+	}
+
+	var iopt InitMediaUploadOption
+	switch len(opt) {
+	case 0:
+		// do nothing
+	case 1:
+		iopt = *opt[0]
+	default:
+		return nil, errors.New("init media upload: only one option is allowed")
+	}
+	form := url.Values{}
+	form.Set("command", "INIT")
+	form.Set("media_type", mediaType)
+	form.Set("total_bytes", strconv.FormatInt(totalBytes, 10))
+	iopt.addForm(form)
+	return mediaUploadCommand(ctx, c, "init media upload", http.MethodPost, form)
+}
+
+func appendMediaUpload(ctx context.Context, c *client, mediaID string, segmentIndex int, chunk []byte) error {
+	switch {
+	case mediaID == "":
+		return errors.New("append media upload: media id parameter is required")
+	case len(chunk) == 0:
+		return errors.New("append media upload: chunk must not be empty")
+	case len(chunk) > maxMediaChunkSize:
+		return fmt.Errorf("append media upload: chunk must be at most %d bytes", maxMediaChunkSize)
+	default:
+	}
+
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	if err := w.WriteField("command", "APPEND"); err != nil {
+		return fmt.Errorf("append media upload: %w", err)
+	}
+	if err := w.WriteField("media_id", mediaID); err != nil {
+		return fmt.Errorf("append media upload: %w", err)
+	}
+	if err := w.WriteField("segment_index", strconv.Itoa(segmentIndex)); err != nil {
+		return fmt.Errorf("append media upload: %w", err)
+	}
+	part, err := w.CreateFormFile("media", "blob")
+	if err != nil {
+		return fmt.Errorf("append media upload: %w", err)
+	}
+	if _, err := part.Write(chunk); err != nil {
+		return fmt.Errorf("append media upload: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("append media upload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.uploadURL+mediaUploadURL, &body)
+	if err != nil {
+		return fmt.Errorf("append media upload new request with ctx: %w", err)
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.bearerToken))
+	req.Header.Set("Content-Type", w.FormDataContentType())
+
+	resp, err := c.do(req)
+	if err != nil {
+		return fmt.Errorf("append media upload: %w", err)
+	}
+	defer resp.Body.Close()
+	if !isSuccess(resp) {
+		return newAPIError("append media upload", req, resp)
+	}
+	return nil
+}
+
+func finalizeMediaUpload(ctx context.Context, c *client, mediaID string) (*MediaUploadResponse, error) {
+	if mediaID == "" {
+		return nil, errors.New("finalize media upload: media id parameter is required")
+	}
+	form := url.Values{}
+	form.Set("command", "FINALIZE")
+	form.Set("media_id", mediaID)
+	return mediaUploadCommand(ctx, c, "finalize media upload", http.MethodPost, form)
+}
+
+func mediaUploadStatus(ctx context.Context, c *client, mediaID string) (*MediaUploadResponse, error) {
+	if mediaID == "" {
+		return nil, errors.New("media upload status: media id parameter is required")
+	}
+	form := url.Values{}
+	form.Set("command", "STATUS")
+	form.Set("media_id", mediaID)
+	return mediaUploadCommand(ctx, c, "media upload status", http.MethodGet, form)
+}
+
+// postMediaJSON sends body to the media metadata endpoints, which answer without a body.
+func postMediaJSON(ctx context.Context, c *client, apiName, path string, body interface{}) error {
+	j, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("%s: can not marshal: %w", apiName, err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.uploadURL+path, bytes.NewReader(j))
+	if err != nil {
+		return fmt.Errorf("%s new request with ctx: %w", apiName, err)
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.bearerToken))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.do(req)
+	if err != nil {
+		return fmt.Errorf("%s: %w", apiName, err)
+	}
+	defer resp.Body.Close()
+	if !isSuccess(resp) {
+		return newAPIError(apiName, req, resp)
+	}
+	return nil
+}
+
+func createMediaMetadata(ctx context.Context, c *client, mediaID string, altText string) error {
+	switch {
+	case mediaID == "":
+		return errors.New("create media metadata: media id parameter is required")
+	case altText == "":
+		return errors.New("create media metadata: alt text parameter is required")
+	default:
+	}
+	return postMediaJSON(ctx, c, "create media metadata", mediaMetadataCreateURL, map[string]interface{}{
+		"media_id": mediaID,
+		"alt_text": map[string]string{"text": altText},
+	})
+}
+
+func createMediaSubtitles(ctx context.Context, c *client, mediaID string, subtitles []*MediaSubtitle) error {
+	switch {
+	case mediaID == "":
+		return errors.New("create media subtitles: media id parameter is required")
+	case len(subtitles) == 0:
+		return errors.New("create media subtitles: subtitles parameter is required")
+	default:
+	}
+	return postMediaJSON(ctx, c, "create media subtitles", mediaSubtitlesCreateURL, map[string]interface{}{
+		"media_id":       mediaID,
+		"media_category": "TweetVideo",
+		"subtitle_info": map[string]interface{}{
+			"subtitles": subtitles,
+		},
+	})
+}
+
+// defaultMediaCategory guesses the category of media used in a Tweet from its MIME type.
+func defaultMediaCategory(mediaType string) MediaCategory {
+	switch {
+	case mediaType == "image/gif":
+		return MediaCategoryTweetGIF
+	case strings.HasPrefix(mediaType, "video/"):
+		return MediaCategoryTweetVideo
+	case strings.HasPrefix(mediaType, "text/"):
+		return MediaCategorySubtitles
+	default:
+		return MediaCategoryTweetImage
+	}
+}
+
+func uploadMedia(ctx context.Context, c *client, mediaType string, media io.Reader, totalBytes int64, opt ...*UploadMediaOption) (*MediaUploadResponse, error) {
+	if media == nil {
+		return nil, errors.New("upload media: media parameter is required")
+	}
+	var uopt UploadMediaOption
+	switch len(opt) {
+	case 0:
+		// do nothing
+	case 1:
+		uopt = *opt[0]
+	default:
+		return nil, errors.New("upload media: only one option is allowed")
+	}
+	if uopt.MediaCategory == "" {
+		uopt.MediaCategory = defaultMediaCategory(mediaType)
+	}
+	if uopt.ChunkSize == 0 {
+		uopt.ChunkSize = defaultMediaChunkSize
+	}
+	if uopt.ChunkSize < 0 || uopt.ChunkSize > maxMediaChunkSize {
+		return nil, fmt.Errorf("upload media: chunk size must be between 1 and %d bytes", maxMediaChunkSize)
+	}
+
+	m, err := initMediaUpload(ctx, c, mediaType, totalBytes, &InitMediaUploadOption{
+		MediaCategory:    uopt.MediaCategory,
+		AdditionalOwners: uopt.AdditionalOwners,
+	})
+	if err != nil {
+		return m, err
+	}
+	mediaID := m.MediaIDString
+
+	// one byte more than expected is enough to find out that media is too long, before it is sent.
+	media = io.LimitReader(media, totalBytes+1)
+	chunk := make([]byte, uopt.ChunkSize)
+	var sent int64
+	for segment := 0; ; segment++ {
+		n, err := io.ReadFull(media, chunk)
+		if sent+int64(n) > totalBytes {
+			return nil, fmt.Errorf("upload media: media is longer than %d bytes", totalBytes)
+		}
+		if n > 0 {
+			if err := appendMediaUpload(ctx, c, mediaID, segment, chunk[:n]); err != nil {
+				return nil, err
+			}
+			sent += int64(n)
+			if uopt.OnProgress != nil {
+				uopt.OnProgress(sent, totalBytes)
+			}
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("upload media: read media: %w", err)
+		}
+	}
+	if sent != totalBytes {
+		return nil, fmt.Errorf("upload media: read %d bytes, want %d", sent, totalBytes)
+	}
+
+	m, err = finalizeMediaUpload(ctx, c, mediaID)
+	if err != nil {
+		return m, err
+	}
+poll:
+	for m.ProcessingInfo != nil {
+		switch m.ProcessingInfo.State {
+		case MediaProcessingSucceeded:
+			break poll
+		case MediaProcessingFailed:
+			if m.ProcessingInfo.Error != nil {
+				return m, fmt.Errorf("upload media: %w", m.ProcessingInfo.Error)
+			}
+			return m, errors.New("upload media: media processing failed")
+		}
+		wait := time.Duration(m.ProcessingInfo.CheckAfterSecs) * time.Second
+		if wait <= 0 {
+			wait = defaultMediaCheckWait
+		}
+		if err := sleepUntil(ctx, time.Now().Add(wait)); err != nil {
+			return m, err
+		}
+		if m, err = mediaUploadStatus(ctx, c, mediaID); err != nil {
+			return m, err
+		}
+	}
+
+	if uopt.AltText != "" {
+		if err := createMediaMetadata(ctx, c, mediaID, uopt.AltText); err != nil {
+			return m, err
+		}
+	}
+	if len(uopt.Subtitles) > 0 {
+		if err := createMediaSubtitles(ctx, c, mediaID, uopt.Subtitles); err != nil {
+			return m, err
+		}
+	}
+	return m, nil
+}
@@ -0,0 +1,165 @@
+package gotwtr_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sivchari/gotwtr"
+	"github.com/sivchari/gotwtr/gotwtrtest"
+)
+
+func Test_UploadMedia(t *testing.T) {
+	t.Parallel()
+	srv := gotwtrtest.NewServer()
+	defer srv.Close()
+	c := srv.Client()
+	ctx := context.Background()
+
+	srt := "1\n00:00:00,000 --> 00:00:01,000\nhello\n"
+	subtitles, err := c.UploadMedia(ctx, "text/srt", strings.NewReader(srt), int64(len(srt)))
+	if err != nil {
+		t.Fatalf("UploadMedia() subtitles error = %v", err)
+	}
+
+	video := bytes.Repeat([]byte("0123456789"), 25)
+	var progress []int64
+	m, err := c.UploadMedia(ctx, "video/mp4", bytes.NewReader(video), int64(len(video)), &gotwtr.UploadMediaOption{
+		ChunkSize: 100,
+		AltText:   "a gopher",
+		Subtitles: []*gotwtr.MediaSubtitle{{MediaID: subtitles.MediaIDString, LanguageCode: "EN", DisplayName: "English"}},
+		OnProgress: func(sent, total int64) {
+			if total != int64(len(video)) {
+				t.Errorf("OnProgress() total = %d, want %d", total, len(video))
+			}
+			progress = append(progress, sent)
+		},
+	})
+	if err != nil {
+		t.Fatalf("UploadMedia() error = %v", err)
+	}
+	if m.ProcessingInfo == nil || m.ProcessingInfo.State != gotwtr.MediaProcessingSucceeded {
+		t.Errorf("UploadMedia() processing info = %+v, want succeeded", m.ProcessingInfo)
+	}
+	if diff := cmp.Diff([]int64{100, 200, 250}, progress); diff != "" {
+		t.Errorf("OnProgress() mismatch (-want +got):\n%s", diff)
+	}
+
+	got, ok := srv.Media(m.MediaIDString)
+	if !ok {
+		t.Fatalf("media %s was not uploaded", m.MediaIDString)
+	}
+	if got.MediaCategory != gotwtr.MediaCategoryTweetVideo {
+		t.Errorf("media category = %q, want %q", got.MediaCategory, gotwtr.MediaCategoryTweetVideo)
+	}
+	if !bytes.Equal(got.Data, video) {
+		t.Errorf("uploaded data = %q, want %q", got.Data, video)
+	}
+	if got.AltText != "a gopher" {
+		t.Errorf("alt text = %q, want %q", got.AltText, "a gopher")
+	}
+	if len(got.Subtitles) != 1 || got.Subtitles[0].MediaID != subtitles.MediaIDString {
+		t.Errorf("subtitles = %+v, want %s", got.Subtitles, subtitles.MediaIDString)
+	}
+}
+
+func Test_UploadMediaProcessingFailed(t *testing.T) {
+	t.Parallel()
+	var segments []string
+	c := gotwtr.New("key", gotwtr.WithHTTPClient(mockHTTPClient(func(req *http.Request) *http.Response {
+		var body string
+		switch {
+		case req.Method == http.MethodGet:
+			body = `{"media_id": 710511363345354753, "media_id_string": "710511363345354753", "processing_info": {"state": "failed", "progress_percent": 0, "error": {"code": 1, "name": "InvalidMedia", "message": "Unsupported video format"}}}`
+		case strings.HasPrefix(req.Header.Get("Content-Type"), "multipart/form-data"):
+			if err := req.ParseMultipartForm(1 << 20); err != nil {
+				t.Fatalf("ParseMultipartForm() error = %v", err)
+			}
+			segments = append(segments, req.FormValue("segment_index"))
+			return &http.Response{StatusCode: http.StatusNoContent, Body: io.NopCloser(strings.NewReader(""))}
+		default:
+			if err := req.ParseForm(); err != nil {
+				t.Fatalf("ParseForm() error = %v", err)
+			}
+			switch req.PostForm.Get("command") {
+			case "INIT":
+				body = `{"media_id": 710511363345354753, "media_id_string": "710511363345354753", "expires_after_secs": 86400}`
+			case "FINALIZE":
+				body = `{"media_id": 710511363345354753, "media_id_string": "710511363345354753", "processing_info": {"state": "pending", "check_after_secs": 0}}`
+			}
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body))}
+	})))
+
+	_, err := c.UploadMedia(context.Background(), "video/mp4", strings.NewReader("not a video"), 11, &gotwtr.UploadMediaOption{ChunkSize: 5})
+	var perr *gotwtr.MediaProcessingError
+	if !errors.As(err, &perr) {
+		t.Fatalf("UploadMedia() error = %v, want *gotwtr.MediaProcessingError", err)
+	}
+	if perr.Name != "InvalidMedia" {
+		t.Errorf("MediaProcessingError.Name = %q, want %q", perr.Name, "InvalidMedia")
+	}
+	if diff := cmp.Diff([]string{"0", "1", "2"}, segments); diff != "" {
+		t.Errorf("segment_index mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func Test_UploadMediaSizeMismatch(t *testing.T) {
+	t.Parallel()
+	srv := gotwtrtest.NewServer()
+	defer srv.Close()
+	_, err := srv.Client().UploadMedia(context.Background(), "image/png", strings.NewReader("short"), 10)
+	if err == nil {
+		t.Fatal("UploadMedia() error = nil, want an error")
+	}
+}
+
+func Test_UploadMediaTooLong(t *testing.T) {
+	t.Parallel()
+	var segments []string
+	c := gotwtr.New("key", gotwtr.WithHTTPClient(mockHTTPClient(func(req *http.Request) *http.Response {
+		if strings.HasPrefix(req.Header.Get("Content-Type"), "multipart/form-data") {
+			if err := req.ParseMultipartForm(1 << 20); err != nil {
+				t.Fatalf("ParseMultipartForm() error = %v", err)
+			}
+			segments = append(segments, req.FormValue("segment_index"))
+			return &http.Response{StatusCode: http.StatusNoContent, Body: io.NopCloser(strings.NewReader(""))}
+		}
+		body := `{"media_id": 710511363345354753, "media_id_string": "710511363345354753", "expires_after_secs": 86400}`
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body))}
+	})))
+
+	_, err := c.UploadMedia(context.Background(), "image/png", strings.NewReader(strings.Repeat("x", 20)), 6, &gotwtr.UploadMediaOption{ChunkSize: 5})
+	if err == nil {
+		t.Fatal("UploadMedia() error = nil, want an error")
+	}
+	// the chunk which goes past the expected size is not sent, nor is the rest of media.
+	if diff := cmp.Diff([]string{"0"}, segments); diff != "" {
+		t.Errorf("segment_index mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func Test_CreateMediaMetadata(t *testing.T) {
+	t.Parallel()
+	c := gotwtr.New("key", gotwtr.WithHTTPClient(mockHTTPClient(func(req *http.Request) *http.Response {
+		var body map[string]interface{}
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			t.Fatalf("Decode() error = %v", err)
+		}
+		want := map[string]interface{}{"media_id": "1", "alt_text": map[string]interface{}{"text": "a gopher"}}
+		if diff := cmp.Diff(want, body); diff != "" {
+			t.Errorf("request body mismatch (-want +got):\n%s", diff)
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(""))}
+	})))
+	if err := c.CreateMediaMetadata(context.Background(), "1", "a gopher"); err != nil {
+		t.Errorf("CreateMediaMetadata() error = %v", err)
+	}
+}
@@ -13,7 +13,7 @@ func muting(ctx context.Context, c *client, userID string, opt ...*MuteOption) (
 	if userID == "" {
 		return nil, errors.New("muting: userID parameter is required")
 	}
-	ep := fmt.Sprintf(mutingURL, userID)
+	ep := c.baseURL + fmt.Sprintf(mutingURL, userID)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ep, nil)
 	if err != nil {
@@ -44,7 +44,7 @@ func muting(ctx context.Context, c *client, userID string, opt ...*MuteOption) (
 	}
 	fopt.addQuery(req)
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("muting response: %w", err)
 	}
@@ -56,11 +56,7 @@ func muting(ctx context.Context, c *client, userID string, opt ...*MuteOption) (
 		return nil, fmt.Errorf("muting: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &m, &HTTPError{
-			APIName: "muting",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &m, newAPIError("muting", req, resp)
 	}
 
 	return &m, nil
@@ -70,7 +66,7 @@ func postMuting(ctx context.Context, c *client, userID string, targetUserID stri
 	if userID == "" {
 		return nil, errors.New("post muting: userID parameter is required")
 	}
-	ep := fmt.Sprintf(postMutingURL, userID)
+	ep := c.baseURL + fmt.Sprintf(postMutingURL, userID)
 
 	if targetUserID == "" {
 		return nil, errors.New("post muting: targetUserID parameter is required")
@@ -90,7 +86,7 @@ func postMuting(ctx context.Context, c *client, userID string, targetUserID stri
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.bearerToken))
 	req.Header.Set("Content-Type", "application/json")
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("post muting response: %w", err)
 	}
@@ -101,11 +97,7 @@ func postMuting(ctx context.Context, c *client, userID string, targetUserID stri
 		return nil, fmt.Errorf("post muting decode: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &postMuting, &HTTPError{
-			APIName: "post muting",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &postMuting, newAPIError("post muting", req, resp)
 	}
 
 	return &postMuting, nil
@@ -118,7 +110,7 @@ func undoMuting(ctx context.Context, c *client, sourceUserID string, targetUserI
 	if targetUserID == "" {
 		return nil, errors.New("undo muting: targetUserID parameter is required")
 	}
-	ep := fmt.Sprintf(undoMutingURL, sourceUserID, targetUserID)
+	ep := c.baseURL + fmt.Sprintf(undoMutingURL, sourceUserID, targetUserID)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, ep, nil)
 	if err != nil {
@@ -126,7 +118,7 @@ func undoMuting(ctx context.Context, c *client, sourceUserID string, targetUserI
 	}
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.bearerToken))
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("undo muting response: %w", err)
 	}
@@ -137,11 +129,7 @@ func undoMuting(ctx context.Context, c *client, sourceUserID string, targetUserI
 		return nil, fmt.Errorf("undo muting decode: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &undoMuting, &HTTPError{
-			APIName: "undo muting",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &undoMuting, newAPIError("undo muting", req, resp)
 	}
 
 	return &undoMuting, nil
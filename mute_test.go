@@ -115,7 +115,7 @@ func Test_muting(t *testing.T) {
 				Users: []*gotwtr.User{
 					{
 						UserName:      "TwitterDev",
-						CreatedAt:     "2013-12-14T04:35:55.000Z",
+						CreatedAt:     mustParseTime("2013-12-14T04:35:55.000Z"),
 						ID:            "2244994945",
 						Name:          "Twitter Dev",
 						PinnedTweetID: "1430984356139470849",
@@ -124,7 +124,7 @@ func Test_muting(t *testing.T) {
 				Includes: &gotwtr.UserIncludes{
 					Tweets: []*gotwtr.Tweet{
 						{
-							CreatedAt: "2021-08-26T20:03:51.000Z",
+							CreatedAt: mustParseTime("2021-08-26T20:03:51.000Z"),
 							ID:        "1430984356139470849",
 							Text:      "Help us build a better Twitter Developer Platform!n nTake the annual developer survey &gt;&gt;&gt; https://t.co/9yTbEKlJHH https://t.co/fYIwKPzqua",
 						},
@@ -0,0 +1,170 @@
+package gotwtr
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// oauth1Credentials signs requests in user context with OAuth 1.0a HMAC-SHA1.
+// FYI https://developer.twitter.com/en/docs/authentication/oauth-1-0a/authorizing-a-request
+type oauth1Credentials struct {
+	consumerKey    string
+	consumerSecret string
+	token          string
+	tokenSecret    string
+}
+
+// WithAccessToken makes the client sign its requests with OAuth 1.0a on behalf of the user of the access token,
+// using the consumer key and secret of WithConsumerKey and WithConsumerSecret.
+func WithAccessToken(accessToken, accessTokenSecret string) ClientOption {
+	return func(c *client) {
+		c.accessToken = accessToken
+		c.accessTokenSecret = accessTokenSecret
+	}
+}
+
+// NewOAuth1Authenticator returns an Authenticator which signs requests with OAuth 1.0a on behalf of the user of the access token.
+func NewOAuth1Authenticator(consumerKey, consumerSecret, accessToken, accessTokenSecret string) Authenticator {
+	return &oauth1Credentials{
+		consumerKey:    consumerKey,
+		consumerSecret: consumerSecret,
+		token:          accessToken,
+		tokenSecret:    accessTokenSecret,
+	}
+}
+
+func (o *oauth1Credentials) Authenticate(req *http.Request) error {
+	if o.consumerKey == "" || o.consumerSecret == "" {
+		return errors.New("oauth1: consumer key and consumer secret are required")
+	}
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return fmt.Errorf("oauth1: %w", err)
+	}
+	return o.sign(req, hex.EncodeToString(nonce), time.Now().Unix())
+}
+
+func (o *oauth1Credentials) sign(req *http.Request, nonce string, timestamp int64) error {
+	oauthParams := map[string]string{
+		"oauth_consumer_key":     o.consumerKey,
+		"oauth_nonce":            nonce,
+		"oauth_signature_method": "HMAC-SHA1",
+		"oauth_timestamp":        strconv.FormatInt(timestamp, 10),
+		"oauth_version":          "1.0",
+	}
+	// requests made before a token is granted, like the request token one, do not send oauth_token.
+	if o.token != "" {
+		oauthParams["oauth_token"] = o.token
+	}
+
+	params := req.URL.Query()
+	form, err := formParams(req)
+	if err != nil {
+		return fmt.Errorf("oauth1: %w", err)
+	}
+	for k, vs := range form {
+		params[k] = append(params[k], vs...)
+	}
+	for k, v := range oauthParams {
+		params.Set(k, v)
+	}
+
+	mac := hmac.New(sha1.New, []byte(percentEncode(o.consumerSecret)+"&"+percentEncode(o.tokenSecret)))
+	mac.Write([]byte(signatureBase(req, params)))
+	oauthParams["oauth_signature"] = base64.StdEncoding.EncodeToString(mac.Sum(nil))
+
+	keys := make([]string, 0, len(oauthParams))
+	for k := range oauthParams {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	header := make([]string, 0, len(keys))
+	for _, k := range keys {
+		header = append(header, percentEncode(k)+`="`+percentEncode(oauthParams[k])+`"`)
+	}
+	req.Header.Set("Authorization", "OAuth "+strings.Join(header, ", "))
+	return nil
+}
+
+// formParams returns the parameters of a form body, which are part of the signature unlike JSON or multipart bodies.
+func formParams(req *http.Request) (url.Values, error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+	if mt, _, _ := mime.ParseMediaType(req.Header.Get("Content-Type")); mt != "application/x-www-form-urlencoded" {
+		return nil, nil
+	}
+	if err := bufferBody(req); err != nil {
+		return nil, err
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	b, err := io.ReadAll(body)
+	if err != nil {
+		return nil, err
+	}
+	return url.ParseQuery(string(b))
+}
+
+// signatureBase builds the signature base string from the method, the URL without query and the sorted parameters.
+func signatureBase(req *http.Request, params url.Values) string {
+	type pair struct{ k, v string }
+	encoded := make([]pair, 0, len(params))
+	for k, vs := range params {
+		for _, v := range vs {
+			encoded = append(encoded, pair{k: percentEncode(k), v: percentEncode(v)})
+		}
+	}
+	// sorted by key then value, not as joined strings, where '=' would be compared with the rest of a longer key.
+	sort.Slice(encoded, func(i, j int) bool {
+		if encoded[i].k != encoded[j].k {
+			return encoded[i].k < encoded[j].k
+		}
+		return encoded[i].v < encoded[j].v
+	})
+	pairs := make([]string, 0, len(encoded))
+	for _, p := range encoded {
+		pairs = append(pairs, p.k+"="+p.v)
+	}
+
+	u := *req.URL
+	u.Scheme = strings.ToLower(u.Scheme)
+	u.Host = strings.ToLower(u.Host)
+	if (u.Scheme == "http" && u.Port() == "80") || (u.Scheme == "https" && u.Port() == "443") {
+		u.Host = u.Hostname()
+	}
+	u.RawQuery = ""
+	u.Fragment = ""
+	return strings.ToUpper(req.Method) + "&" + percentEncode(u.String()) + "&" + percentEncode(strings.Join(pairs, "&"))
+}
+
+// percentEncode encodes s as RFC 3986 requires, leaving only the unreserved characters as they are.
+func percentEncode(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+		switch {
+		case 'A' <= ch && ch <= 'Z', 'a' <= ch && ch <= 'z', '0' <= ch && ch <= '9', ch == '-', ch == '.', ch == '_', ch == '~':
+			b.WriteByte(ch)
+		default:
+			fmt.Fprintf(&b, "%%%02X", ch)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,121 @@
+package gotwtr_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/sivchari/gotwtr"
+)
+
+func Test_SignOAuth1(t *testing.T) {
+	t.Parallel()
+	// the example of https://developer.twitter.com/en/docs/authentication/oauth-1-0a/creating-a-signature
+	req, err := http.NewRequest(http.MethodPost, "https://api.twitter.com/1.1/statuses/update.json?include_entities=true",
+		strings.NewReader("status=Hello%20Ladies%20%2b%20Gentlemen%2c%20a%20signed%20OAuth%20request%21"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	err = gotwtr.SignOAuth1(req,
+		"xvz1evFS4wEEPTGEFPHBog", "kAcSOqF21Fu85e7zjz7ZN2U4ZRhfV3WpwPAoE3Z7kBw",
+		"370773112-GmHxMAgYyLbNEtIKZeRNFsMKPR9EyMZeS9weJAEb", "LswwdoUaIvS8ltyTt5jkRh4J50vUPVVHtR2YPi5kE",
+		"kYjzVBB8Y0ZFabxSWbWovY3uYSQ2pTgmZeNu2VS4cg", 1318622958)
+	if err != nil {
+		t.Fatalf("SignOAuth1() error = %v", err)
+	}
+	want := `OAuth oauth_consumer_key="xvz1evFS4wEEPTGEFPHBog", ` +
+		`oauth_nonce="kYjzVBB8Y0ZFabxSWbWovY3uYSQ2pTgmZeNu2VS4cg", ` +
+		`oauth_signature="hCtSmYh%2BiHYCEqBWrE7C7hYmtUk%3D", ` +
+		`oauth_signature_method="HMAC-SHA1", ` +
+		`oauth_timestamp="1318622958", ` +
+		`oauth_token="370773112-GmHxMAgYyLbNEtIKZeRNFsMKPR9EyMZeS9weJAEb", ` +
+		`oauth_version="1.0"`
+	if got := req.Header.Get("Authorization"); got != want {
+		t.Errorf("Authorization = %q, want %q", got, want)
+	}
+	b, err := io.ReadAll(req.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(b), "status=Hello") {
+		t.Errorf("body = %q, the signer must not consume it", b)
+	}
+}
+
+func Test_SignOAuth1WithoutToken(t *testing.T) {
+	t.Parallel()
+	req, err := http.NewRequest(http.MethodPost, "https://api.twitter.com/oauth/request_token", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = gotwtr.SignOAuth1(req,
+		"xvz1evFS4wEEPTGEFPHBog", "kAcSOqF21Fu85e7zjz7ZN2U4ZRhfV3WpwPAoE3Z7kBw", "", "",
+		"kYjzVBB8Y0ZFabxSWbWovY3uYSQ2pTgmZeNu2VS4cg", 1318622958)
+	if err != nil {
+		t.Fatalf("SignOAuth1() error = %v", err)
+	}
+	want := `OAuth oauth_consumer_key="xvz1evFS4wEEPTGEFPHBog", ` +
+		`oauth_nonce="kYjzVBB8Y0ZFabxSWbWovY3uYSQ2pTgmZeNu2VS4cg", ` +
+		`oauth_signature="OLS7uhmFFgV4TzxaSB%2B%2BBXR1pRY%3D", ` +
+		`oauth_signature_method="HMAC-SHA1", ` +
+		`oauth_timestamp="1318622958", ` +
+		`oauth_version="1.0"`
+	if got := req.Header.Get("Authorization"); got != want {
+		t.Errorf("Authorization = %q, want %q", got, want)
+	}
+}
+
+func Test_SignOAuth1KeyPrefix(t *testing.T) {
+	t.Parallel()
+	// "a" sorts before "a.b", although "a=1" sorts after "a.b=2".
+	req, err := http.NewRequest(http.MethodGet, "https://api.twitter.com/2/tweets?a.b=2&a=1", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = gotwtr.SignOAuth1(req,
+		"xvz1evFS4wEEPTGEFPHBog", "kAcSOqF21Fu85e7zjz7ZN2U4ZRhfV3WpwPAoE3Z7kBw",
+		"370773112-GmHxMAgYyLbNEtIKZeRNFsMKPR9EyMZeS9weJAEb", "LswwdoUaIvS8ltyTt5jkRh4J50vUPVVHtR2YPi5kE",
+		"kYjzVBB8Y0ZFabxSWbWovY3uYSQ2pTgmZeNu2VS4cg", 1318622958)
+	if err != nil {
+		t.Fatalf("SignOAuth1() error = %v", err)
+	}
+	if got := req.Header.Get("Authorization"); !strings.Contains(got, `oauth_signature="MyvQgE1C0qsSKlfnhJJqK0BxEWc%3D"`) {
+		t.Errorf("Authorization = %q, want the signature of the parameters sorted by key", got)
+	}
+}
+
+func Test_OAuth1UserContext(t *testing.T) {
+	t.Parallel()
+	var nonces []string
+	c := gotwtr.New("",
+		gotwtr.WithConsumerKey("consumerKey"),
+		gotwtr.WithConsumerSecret("consumerSecret"),
+		gotwtr.WithAccessToken("accessToken", "accessTokenSecret"),
+		gotwtr.WithRetryPolicy(&gotwtr.RetryPolicy{MaxAttempts: 2, BaseDelay: 1}),
+		gotwtr.WithHTTPClient(mockHTTPClient(func(req *http.Request) *http.Response {
+			auth := req.Header.Get("Authorization")
+			if !strings.HasPrefix(auth, "OAuth ") || !strings.Contains(auth, `oauth_token="accessToken"`) {
+				t.Errorf("Authorization = %q, want an OAuth 1.0a header", auth)
+			}
+			for _, p := range strings.Split(strings.TrimPrefix(auth, "OAuth "), ", ") {
+				if strings.HasPrefix(p, "oauth_nonce=") {
+					nonces = append(nonces, p)
+				}
+			}
+			if len(nonces) == 1 {
+				return &http.Response{StatusCode: http.StatusServiceUnavailable, Status: "Service Unavailable", Body: io.NopCloser(strings.NewReader(`{}`))}
+			}
+			data := `{"data": {"id": "2244994945", "name": "Twitter Dev", "username": "TwitterDev"}}`
+			return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(data))}
+		})),
+	)
+	if _, err := c.Me(context.Background()); err != nil {
+		t.Fatalf("Me() error = %v", err)
+	}
+	if len(nonces) != 2 || nonces[0] == nonces[1] {
+		t.Errorf("nonces = %v, want a new nonce for the retry", nonces)
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"errors"
 	"io"
 	"net/http"
+	"net/url"
+	"strings"
 )
 
 type oauth struct {
@@ -30,45 +32,77 @@ func (o *oauth) unmarshalJSON(b io.ReadCloser) error {
 	return nil
 }
 
-func generateAppOnlyBearerToken(ctx context.Context, c *client) (bool, error) {
+// requestAppOnlyBearerToken obtains an app-only bearer token with the consumer key and secret of c.
+func requestAppOnlyBearerToken(ctx context.Context, c *client) (string, error) {
+	var o oauth
+	if err := postConsumerForm(ctx, c, "generate app only bearer token", generateAppOnlyBearerTokenURL, nil, &o); err != nil {
+		return "", err
+	}
+	return o.accessToken, nil
+}
+
+// postConsumerForm sends form to an OAuth endpoint authenticated with the consumer key and secret of c.
+func postConsumerForm(ctx context.Context, c *client, apiName, path string, form url.Values, o *oauth) error {
 	ck := c.consumerKey
 	cs := c.consumerSecret
 	if ck == "" {
-		return false, errors.New("consumer key is empty")
+		return errors.New("consumer key is empty")
 	}
 	if cs == "" {
-		return false, errors.New("consumer secret is empty")
+		return errors.New("consumer secret is empty")
 	}
 	credentials := ck + ":" + cs
 	b64credentials := base64.StdEncoding.EncodeToString([]byte(credentials))
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, generateAppOnlyBearerTokenURL, nil)
+	var body io.Reader
+	if form != nil {
+		body = strings.NewReader(form.Encode())
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.authURL+path, body)
 	if err != nil {
-		return false, err
+		return err
 	}
 	req.Header.Set("Authorization", "Basic "+b64credentials)
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded;charset=UTF-8")
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
-		return false, err
+		return err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return false, &HTTPError{
-			APIName: "generate app only bearer token",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return newAPIError(apiName, req, resp)
 	}
+	return o.unmarshalJSON(resp.Body)
+}
 
-	var o oauth
-	err = o.unmarshalJSON(resp.Body)
+func generateAppOnlyBearerToken(ctx context.Context, c *client) (bool, error) {
+	token, err := requestAppOnlyBearerToken(ctx, c)
 	if err != nil {
 		return false, err
 	}
+	c.setBearerToken(token)
+	return true, nil
+}
 
-	c.bearerToken = o.accessToken
-
+// invalidateToken invalidates the app-only bearer token of c. The following requests acquire a new token
+// when the client uses WithAppOnlyAuth, and fail otherwise until a new bearer token is set.
+func invalidateToken(ctx context.Context, c *client) (bool, error) {
+	token := c.currentBearerToken()
+	if token == "" {
+		return false, errors.New("invalidate token: bearer token is empty")
+	}
+	form := url.Values{}
+	form.Set("access_token", token)
+	var o oauth
+	if err := postConsumerForm(ctx, c, "invalidate token", invalidateTokenURL, form, &o); err != nil {
+		return false, err
+	}
+	c.authMu.Lock()
+	defer c.authMu.Unlock()
+	// another goroutine may already have swapped the token, which must not be cleared then.
+	if s, ok := c.auth.(tokenSetter); ok && s.Token() == token {
+		s.SetToken("")
+	}
 	return true, nil
 }
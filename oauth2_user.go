@@ -0,0 +1,231 @@
+package gotwtr
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// OAuth2Scope is a scope of the OAuth 2.0 Authorization Code with PKCE flow.
+type OAuth2Scope string
+
+const (
+	ScopeTweetRead          OAuth2Scope = "tweet.read"
+	ScopeTweetWrite         OAuth2Scope = "tweet.write"
+	ScopeTweetModerate      OAuth2Scope = "tweet.moderate.write"
+	ScopeUsersRead          OAuth2Scope = "users.read"
+	ScopeFollowsRead        OAuth2Scope = "follows.read"
+	ScopeFollowsWrite       OAuth2Scope = "follows.write"
+	ScopeOfflineAccess      OAuth2Scope = "offline.access"
+	ScopeSpaceRead          OAuth2Scope = "space.read"
+	ScopeMuteRead           OAuth2Scope = "mute.read"
+	ScopeMuteWrite          OAuth2Scope = "mute.write"
+	ScopeLikeRead           OAuth2Scope = "like.read"
+	ScopeLikeWrite          OAuth2Scope = "like.write"
+	ScopeListRead           OAuth2Scope = "list.read"
+	ScopeListWrite          OAuth2Scope = "list.write"
+	ScopeBlockRead          OAuth2Scope = "block.read"
+	ScopeBlockWrite         OAuth2Scope = "block.write"
+	ScopeBookmarkRead       OAuth2Scope = "bookmark.read"
+	ScopeBookmarkWrite      OAuth2Scope = "bookmark.write"
+	ScopeDirectMessageRead  OAuth2Scope = "dm.read"
+	ScopeDirectMessageWrite OAuth2Scope = "dm.write"
+)
+
+// OAuth2Config is the configuration of an app using the OAuth 2.0 Authorization Code with PKCE flow.
+type OAuth2Config struct {
+	ClientID string
+	// ClientSecret is only set for confidential clients, which authenticate to the token endpoint with it.
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []OAuth2Scope
+	// AuthorizeURL defaults to https://twitter.com/i/oauth2/authorize.
+	AuthorizeURL string
+}
+
+// PKCE is a code verifier and its challenge.
+type PKCE struct {
+	Verifier  string
+	Challenge string
+	// Method is always S256.
+	Method string
+}
+
+// NewPKCE generates a random code verifier and its S256 challenge.
+func NewPKCE() (*PKCE, error) {
+	verifier, err := randomString(32)
+	if err != nil {
+		return nil, fmt.Errorf("new pkce: %w", err)
+	}
+	sum := sha256.Sum256([]byte(verifier))
+	return &PKCE{
+		Verifier:  verifier,
+		Challenge: base64.RawURLEncoding.EncodeToString(sum[:]),
+		Method:    "S256",
+	}, nil
+}
+
+// NewOAuth2State generates a random state to protect the authorization from CSRF.
+func NewOAuth2State() (string, error) {
+	s, err := randomString(16)
+	if err != nil {
+		return "", fmt.Errorf("new oauth2 state: %w", err)
+	}
+	return s, nil
+}
+
+func randomString(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// AuthCodeURL returns the URL of the page where the user authorizes the app.
+// The state and the verifier of pkce must be kept until the user is redirected back to RedirectURL.
+func (cfg *OAuth2Config) AuthCodeURL(state string, pkce *PKCE) string {
+	scopes := make([]string, 0, len(cfg.Scopes))
+	for _, s := range cfg.Scopes {
+		scopes = append(scopes, string(s))
+	}
+	q := url.Values{}
+	q.Set("response_type", "code")
+	q.Set("client_id", cfg.ClientID)
+	q.Set("redirect_uri", cfg.RedirectURL)
+	q.Set("scope", strings.Join(scopes, " "))
+	q.Set("state", state)
+	q.Set("code_challenge", pkce.Challenge)
+	q.Set("code_challenge_method", pkce.Method)
+	ep := cfg.AuthorizeURL
+	if ep == "" {
+		ep = defaultAuthorizeURL
+	}
+	return ep + "?" + q.Encode()
+}
+
+// OAuth2Token is a user access token. It is the value persisted by a TokenStore.
+type OAuth2Token struct {
+	AccessToken  string    `json:"access_token"`
+	RefreshToken string    `json:"refresh_token,omitempty"`
+	TokenType    string    `json:"token_type,omitempty"`
+	Scope        string    `json:"scope,omitempty"`
+	Expiry       time.Time `json:"expiry,omitempty"`
+}
+
+// expired reports whether t expires within delta. Tokens without expiry never expire.
+func (t *OAuth2Token) expired(delta time.Duration) bool {
+	return !t.Expiry.IsZero() && !time.Now().Add(delta).Before(t.Expiry)
+}
+
+type RevokeOAuth2TokenResponse struct {
+	Revoked bool `json:"revoked"`
+}
+
+// postOAuth2Form sends form to an OAuth 2.0 endpoint, authenticating confidential clients with their secret
+// and public clients with their client id.
+func postOAuth2Form(ctx context.Context, c *client, apiName, path string, cfg *OAuth2Config, form url.Values, v interface{}) error {
+	if cfg == nil || cfg.ClientID == "" {
+		return fmt.Errorf("%s: client id is required", apiName)
+	}
+	if cfg.ClientSecret == "" {
+		form.Set("client_id", cfg.ClientID)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.authURL+path, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("%s new request with ctx: %w", apiName, err)
+	}
+	if cfg.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(cfg.ClientID), url.QueryEscape(cfg.ClientSecret))
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := c.do(req)
+	if err != nil {
+		return fmt.Errorf("%s: %w", apiName, err)
+	}
+	defer resp.Body.Close()
+
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("%s decode: %w", apiName, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return newAPIError(apiName, req, resp)
+	}
+	return nil
+}
+
+func requestOAuth2Token(ctx context.Context, c *client, apiName string, cfg *OAuth2Config, form url.Values) (*OAuth2Token, error) {
+	var t struct {
+		OAuth2Token
+		ExpiresIn int64 `json:"expires_in"`
+	}
+	if err := postOAuth2Form(ctx, c, apiName, oauth2TokenURL, cfg, form, &t); err != nil {
+		return nil, err
+	}
+	token := t.OAuth2Token
+	if t.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(t.ExpiresIn) * time.Second)
+	}
+	return &token, nil
+}
+
+func exchangeOAuth2Code(ctx context.Context, c *client, cfg *OAuth2Config, code string, codeVerifier string) (*OAuth2Token, error) {
+	switch {
+	case code == "":
+		return nil, errors.New("exchange oauth2 code: code parameter is required")
+	case codeVerifier == "":
+		return nil, errors.New("exchange oauth2 code: code verifier parameter is required")
+	default:
+	}
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("code_verifier", codeVerifier)
+	if cfg != nil {
+		form.Set("redirect_uri", cfg.RedirectURL)
+	}
+	return requestOAuth2Token(ctx, c, "exchange oauth2 code", cfg, form)
+}
+
+func refreshOAuth2Token(ctx context.Context, c *client, cfg *OAuth2Config, refreshToken string) (*OAuth2Token, error) {
+	if refreshToken == "" {
+		return nil, errors.New("refresh oauth2 token: refresh token parameter is required")
+	}
+	form := url.Values{}
+	form.Set("grant_type", "refresh_token")
+	form.Set("refresh_token", refreshToken)
+	t, err := requestOAuth2Token(ctx, c, "refresh oauth2 token", cfg, form)
+	if err != nil {
+		return nil, err
+	}
+	if t.RefreshToken == "" {
+		// refresh tokens are rotated, but keep the previous one if the server did not issue a new one.
+		t.RefreshToken = refreshToken
+	}
+	return t, nil
+}
+
+func revokeOAuth2Token(ctx context.Context, c *client, cfg *OAuth2Config, token string, tokenTypeHint string) (*RevokeOAuth2TokenResponse, error) {
+	if token == "" {
+		return nil, errors.New("revoke oauth2 token: token parameter is required")
+	}
+	form := url.Values{}
+	form.Set("token", token)
+	if tokenTypeHint != "" {
+		form.Set("token_type_hint", tokenTypeHint)
+	}
+	var r RevokeOAuth2TokenResponse
+	if err := postOAuth2Form(ctx, c, "revoke oauth2 token", oauth2RevokeURL, cfg, form, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
@@ -0,0 +1,157 @@
+package gotwtr_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sivchari/gotwtr"
+)
+
+func Test_AuthCodeURL(t *testing.T) {
+	t.Parallel()
+	pkce, err := gotwtr.NewPKCE()
+	if err != nil {
+		t.Fatalf("NewPKCE() error = %v", err)
+	}
+	sum := sha256.Sum256([]byte(pkce.Verifier))
+	if want := base64.RawURLEncoding.EncodeToString(sum[:]); pkce.Challenge != want {
+		t.Errorf("PKCE.Challenge = %q, want %q", pkce.Challenge, want)
+	}
+	cfg := &gotwtr.OAuth2Config{
+		ClientID:    "client",
+		RedirectURL: "https://example.com/callback",
+		Scopes:      []gotwtr.OAuth2Scope{gotwtr.ScopeTweetRead, gotwtr.ScopeUsersRead, gotwtr.ScopeOfflineAccess},
+	}
+	u, err := url.Parse(cfg.AuthCodeURL("state", pkce))
+	if err != nil {
+		t.Fatalf("url.Parse() error = %v", err)
+	}
+	if got := u.Scheme + "://" + u.Host + u.Path; got != "https://twitter.com/i/oauth2/authorize" {
+		t.Errorf("AuthCodeURL() endpoint = %q", got)
+	}
+	want := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {"client"},
+		"redirect_uri":          {"https://example.com/callback"},
+		"scope":                 {"tweet.read users.read offline.access"},
+		"state":                 {"state"},
+		"code_challenge":        {pkce.Challenge},
+		"code_challenge_method": {"S256"},
+	}
+	if diff := cmp.Diff(want, u.Query()); diff != "" {
+		t.Errorf("AuthCodeURL() query mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func Test_ExchangeOAuth2Code(t *testing.T) {
+	t.Parallel()
+	cfg := &gotwtr.OAuth2Config{ClientID: "client", RedirectURL: "https://example.com/callback"}
+	c := gotwtr.New("", gotwtr.WithHTTPClient(mockHTTPClient(func(req *http.Request) *http.Response {
+		if err := req.ParseForm(); err != nil {
+			t.Fatalf("ParseForm() error = %v", err)
+		}
+		want := url.Values{
+			"grant_type":    {"authorization_code"},
+			"code":          {"code"},
+			"code_verifier": {"verifier"},
+			"redirect_uri":  {"https://example.com/callback"},
+			"client_id":     {"client"},
+		}
+		if diff := cmp.Diff(want, req.PostForm); diff != "" {
+			t.Errorf("request form mismatch (-want +got):\n%s", diff)
+		}
+		if req.Header.Get("Authorization") != "" {
+			t.Errorf("public clients must not send an Authorization header")
+		}
+		data := `{"token_type": "bearer", "expires_in": 7200, "access_token": "access", "scope": "tweet.read offline.access", "refresh_token": "refresh"}`
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(data))}
+	})))
+	got, err := c.ExchangeOAuth2Code(context.Background(), cfg, "code", "verifier")
+	if err != nil {
+		t.Fatalf("ExchangeOAuth2Code() error = %v", err)
+	}
+	if got.AccessToken != "access" || got.RefreshToken != "refresh" || got.Scope != "tweet.read offline.access" {
+		t.Errorf("ExchangeOAuth2Code() = %+v", got)
+	}
+	if d := time.Until(got.Expiry); d < 119*time.Minute || d > 2*time.Hour {
+		t.Errorf("ExchangeOAuth2Code() expiry in %v, want 2h", d)
+	}
+}
+
+func Test_OAuth2TokenRefresh(t *testing.T) {
+	t.Parallel()
+	cfg := &gotwtr.OAuth2Config{ClientID: "client", ClientSecret: "secret"}
+	store := gotwtr.NewMemoryTokenStore(&gotwtr.OAuth2Token{
+		AccessToken:  "expired",
+		RefreshToken: "refresh1",
+		Expiry:       time.Now().Add(-time.Minute),
+	})
+	var refreshes int
+	c := gotwtr.New("", gotwtr.WithOAuth2Token(cfg, store), gotwtr.WithHTTPClient(mockHTTPClient(func(req *http.Request) *http.Response {
+		if req.URL.Path == "/2/oauth2/token" {
+			refreshes++
+			if id, secret, ok := req.BasicAuth(); !ok || id != "client" || secret != "secret" {
+				t.Errorf("confidential clients must authenticate with basic auth")
+			}
+			if err := req.ParseForm(); err != nil {
+				t.Fatalf("ParseForm() error = %v", err)
+			}
+			if got := req.PostForm.Get("refresh_token"); got != "refresh1" {
+				t.Errorf("refresh_token = %q, want refresh1", got)
+			}
+			data := `{"token_type": "bearer", "expires_in": 7200, "access_token": "access2", "refresh_token": "refresh2"}`
+			return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(data))}
+		}
+		if got := req.Header.Get("Authorization"); got != "Bearer access2" {
+			t.Errorf("Authorization = %q, want Bearer access2", got)
+		}
+		data := `{"data": {"id": "2244994945", "name": "Twitter Dev", "username": "TwitterDev"}}`
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(data))}
+	})))
+	for i := 0; i < 2; i++ {
+		if _, err := c.Me(context.Background()); err != nil {
+			t.Fatalf("Me() error = %v", err)
+		}
+	}
+	if refreshes != 1 {
+		t.Errorf("refreshes = %d, want 1", refreshes)
+	}
+	saved, err := store.Load(context.Background())
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if saved.AccessToken != "access2" || saved.RefreshToken != "refresh2" {
+		t.Errorf("saved token = %+v, want the rotated token", saved)
+	}
+}
+
+func Test_OAuth2TokenErrors(t *testing.T) {
+	t.Parallel()
+	c := gotwtr.New("", gotwtr.WithOAuth2Token(&gotwtr.OAuth2Config{ClientID: "client"}, gotwtr.NewMemoryTokenStore(nil)))
+	if _, err := c.Me(context.Background()); !errors.Is(err, gotwtr.ErrNoToken) {
+		t.Errorf("Me() error = %v, want ErrNoToken", err)
+	}
+
+	c = gotwtr.New("", gotwtr.WithHTTPClient(mockHTTPClient(func(req *http.Request) *http.Response {
+		data := `{"error": "invalid_request", "error_description": "Value passed for the token was invalid."}`
+		return &http.Response{StatusCode: http.StatusBadRequest, Status: "Bad Request", Body: io.NopCloser(strings.NewReader(data))}
+	})))
+	_, err := c.RevokeOAuth2Token(context.Background(), &gotwtr.OAuth2Config{ClientID: "client"}, "token", "access_token")
+	var apiErr *gotwtr.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("RevokeOAuth2Token() error = %v, want *gotwtr.APIError", err)
+	}
+	if apiErr.Title != "invalid_request" || apiErr.Detail != "Value passed for the token was invalid." {
+		t.Errorf("APIError = %+v", apiErr)
+	}
+}
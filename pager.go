@@ -0,0 +1,510 @@
+package gotwtr
+
+import (
+	"context"
+	"errors"
+)
+
+// Pager iterates over the pages of a paginated endpoint.
+// T is the type of the items of a page and I the type of the includes, which are accumulated across pages.
+//
+//	p := client.FollowersPager(ctx, userID)
+//	for p.Next() {
+//		for _, u := range p.Page() {
+//			fmt.Println(u.UserName)
+//		}
+//	}
+//	if err := p.Err(); err != nil {
+//		return err
+//	}
+type Pager[T, I any] struct {
+	// MaxItems stops the iteration once this many items have been returned. Zero means no limit.
+	// When it cuts a page short, NextToken is the token of that page, so a resumed iteration
+	// returns the whole page again, including the items which were already returned.
+	MaxItems int
+	// MaxPages stops the iteration once this many pages have been fetched. Zero means no limit.
+	MaxPages int
+
+	ctx      context.Context
+	fetch    func(ctx context.Context, token string) ([]T, *I, string, error)
+	merge    func(dst, src *I)
+	page     []T
+	includes I
+	token    string
+	pages    int
+	items    int
+	done     bool
+	err      error
+}
+
+// newPager builds a Pager which copies the single option of opt and sets the pagination token returned by tokenField before each fetch.
+func newPager[O, T, I any](ctx context.Context, opt []*O, tokenField func(o *O) *string, fetch func(ctx context.Context, o *O) ([]T, *I, string, error), merge func(dst, src *I)) *Pager[T, I] {
+	p := &Pager[T, I]{
+		ctx:   ctx,
+		merge: merge,
+	}
+	var base O
+	switch len(opt) {
+	case 0:
+		// do nothing
+	case 1:
+		base = *opt[0]
+	default:
+		p.err = errors.New("pager: only one option is allowed")
+	}
+	// the token of the first page comes from opt, which allows resuming from NextToken.
+	p.token = *tokenField(&base)
+	p.fetch = func(ctx context.Context, token string) ([]T, *I, string, error) {
+		o := base
+		*tokenField(&o) = token
+		return fetch(ctx, &o)
+	}
+	return p
+}
+
+// Next fetches the next page. It returns false when there are no more pages, a limit is reached or an error occurred.
+func (p *Pager[T, I]) Next() bool {
+	if p.done || p.err != nil {
+		return false
+	}
+	if (p.MaxPages > 0 && p.pages >= p.MaxPages) || (p.MaxItems > 0 && p.items >= p.MaxItems) {
+		p.done = true
+		return false
+	}
+	items, includes, next, err := p.fetch(p.ctx, p.token)
+	if err != nil {
+		p.err = err
+		p.page = nil
+		return false
+	}
+	p.pages++
+	if includes != nil && p.merge != nil {
+		p.merge(&p.includes, includes)
+	}
+	if p.MaxItems > 0 && p.items+len(items) > p.MaxItems {
+		// the page is cut short: NextToken keeps pointing at it, so that the items left out are not lost.
+		items = items[:p.MaxItems-p.items]
+		p.done = true
+	} else {
+		p.token = next
+		p.done = next == ""
+	}
+	p.items += len(items)
+	p.page = items
+	return true
+}
+
+// Page returns the items of the page fetched by the last call to Next.
+func (p *Pager[T, I]) Page() []T {
+	return p.page
+}
+
+// Includes returns the includes of every page fetched so far, without duplicates.
+func (p *Pager[T, I]) Includes() *I {
+	return &p.includes
+}
+
+// NextToken returns the pagination token of the page after the current one, or of the current one when MaxItems
+// cut it short. It can be used to resume the iteration later.
+func (p *Pager[T, I]) NextToken() string {
+	return p.token
+}
+
+// Err returns the error which stopped the iteration, if any.
+func (p *Pager[T, I]) Err() error {
+	return p.err
+}
+
+// ForEach calls fn for every item of every page until the iteration ends or fn returns an error.
+func (p *Pager[T, I]) ForEach(fn func(item T) error) error {
+	for p.Next() {
+		for _, item := range p.Page() {
+			if err := fn(item); err != nil {
+				return err
+			}
+		}
+	}
+	return p.Err()
+}
+
+// appendUnique appends the items of src to dst which key does not already appear in dst.
+func appendUnique[T any](dst, src []T, key func(T) string) []T {
+	seen := make(map[string]bool, len(dst))
+	for _, v := range dst {
+		seen[key(v)] = true
+	}
+	for _, v := range src {
+		if k := key(v); !seen[k] {
+			seen[k] = true
+			dst = append(dst, v)
+		}
+	}
+	return dst
+}
+
+func userKey(u *User) string   { return u.ID }
+func tweetKey(t *Tweet) string { return t.ID }
+func mediaKey(m *Media) string { return m.MediaKey }
+func placeKey(p *Place) string { return p.ID }
+func pollKey(p *Poll) string   { return p.ID }
+
+func mergeUserIncludes(dst, src *UserIncludes) {
+	dst.Users = appendUnique(dst.Users, src.Users, userKey)
+	dst.Tweets = appendUnique(dst.Tweets, src.Tweets, tweetKey)
+}
+
+func mergeTweetIncludes(dst, src *TweetIncludes) {
+	dst.Media = appendUnique(dst.Media, src.Media, mediaKey)
+	dst.Places = appendUnique(dst.Places, src.Places, placeKey)
+	dst.Polls = appendUnique(dst.Polls, src.Polls, pollKey)
+	dst.Tweets = appendUnique(dst.Tweets, src.Tweets, tweetKey)
+	dst.Users = appendUnique(dst.Users, src.Users, userKey)
+}
+
+func mergeDirectMessageIncludes(dst, src *DirectMessageIncludes) {
+	dst.Media = appendUnique(dst.Media, src.Media, mediaKey)
+	dst.Tweets = appendUnique(dst.Tweets, src.Tweets, tweetKey)
+	dst.Users = appendUnique(dst.Users, src.Users, userKey)
+}
+
+// nextToken returns the token of the next page, which is empty on the last page or when the response has no meta.
+func (m *FollowsMeta) nextToken() string {
+	if m == nil {
+		return ""
+	}
+	return m.NextToken
+}
+
+func (m *BlocksMeta) nextToken() string {
+	if m == nil {
+		return ""
+	}
+	return m.NextToken
+}
+
+func (m *MutesMeta) nextToken() string {
+	if m == nil {
+		return ""
+	}
+	return m.NextToken
+}
+
+func (m *UserTimelineMeta) nextToken() string {
+	if m == nil {
+		return ""
+	}
+	return m.NextToken
+}
+
+func (m *QuoteTweetsMeta) nextToken() string {
+	if m == nil {
+		return ""
+	}
+	return m.NextToken
+}
+
+func (m *SearchTweetsMeta) nextToken() string {
+	if m == nil {
+		return ""
+	}
+	return m.NextToken
+}
+
+func (m *LookupUserBookmarksMeta) nextToken() string {
+	if m == nil {
+		return ""
+	}
+	return m.NextToken
+}
+
+func (m *TweetsUserLikedMeta) nextToken() string {
+	if m == nil {
+		return ""
+	}
+	return m.NextToken
+}
+
+func (m *ListMeta) nextToken() string {
+	if m == nil {
+		return ""
+	}
+	return m.NextToken
+}
+
+func (m *DirectMessageMeta) nextToken() string {
+	if m == nil {
+		return ""
+	}
+	return m.NextToken
+}
+
+func (m *TweetCountMeta) nextToken() string {
+	if m == nil {
+		return ""
+	}
+	return m.NextToken
+}
+
+// FollowersPager returns a Pager over every page of Followers.
+func (c *Client) FollowersPager(ctx context.Context, userID string, opt ...*FollowOption) *Pager[*User, UserIncludes] {
+	return newPager(ctx, opt, func(o *FollowOption) *string { return &o.PaginationToken },
+		func(ctx context.Context, o *FollowOption) ([]*User, *UserIncludes, string, error) {
+			resp, err := followers(ctx, c.client, userID, o)
+			if err != nil {
+				return nil, nil, "", err
+			}
+			return resp.Users, resp.Includes, resp.Meta.nextToken(), nil
+		}, mergeUserIncludes)
+}
+
+// FollowingPager returns a Pager over every page of Following.
+func (c *Client) FollowingPager(ctx context.Context, userID string, opt ...*FollowOption) *Pager[*User, UserIncludes] {
+	return newPager(ctx, opt, func(o *FollowOption) *string { return &o.PaginationToken },
+		func(ctx context.Context, o *FollowOption) ([]*User, *UserIncludes, string, error) {
+			resp, err := following(ctx, c.client, userID, o)
+			if err != nil {
+				return nil, nil, "", err
+			}
+			return resp.Users, resp.Includes, resp.Meta.nextToken(), nil
+		}, mergeUserIncludes)
+}
+
+// BlockingPager returns a Pager over every page of Blocking.
+func (c *Client) BlockingPager(ctx context.Context, userID string, opt ...*BlockOption) *Pager[*User, UserIncludes] {
+	return newPager(ctx, opt, func(o *BlockOption) *string { return &o.PaginationToken },
+		func(ctx context.Context, o *BlockOption) ([]*User, *UserIncludes, string, error) {
+			resp, err := blocking(ctx, c.client, userID, o)
+			if err != nil {
+				return nil, nil, "", err
+			}
+			return resp.Users, resp.Includes, resp.Meta.nextToken(), nil
+		}, mergeUserIncludes)
+}
+
+// MutingPager returns a Pager over every page of Muting.
+func (c *Client) MutingPager(ctx context.Context, userID string, opt ...*MuteOption) *Pager[*User, UserIncludes] {
+	return newPager(ctx, opt, func(o *MuteOption) *string { return &o.PaginationToken },
+		func(ctx context.Context, o *MuteOption) ([]*User, *UserIncludes, string, error) {
+			resp, err := muting(ctx, c.client, userID, o)
+			if err != nil {
+				return nil, nil, "", err
+			}
+			return resp.Users, resp.Includes, resp.Meta.nextToken(), nil
+		}, mergeUserIncludes)
+}
+
+// UserTweetTimelinePager returns a Pager over every page of UserTweetTimeline.
+func (c *Client) UserTweetTimelinePager(ctx context.Context, userID string, opt ...*UserTweetTimelineOption) *Pager[*Tweet, TweetIncludes] {
+	return newPager(ctx, opt, func(o *UserTweetTimelineOption) *string { return &o.PaginationToken },
+		func(ctx context.Context, o *UserTweetTimelineOption) ([]*Tweet, *TweetIncludes, string, error) {
+			resp, err := userTweetTimeline(ctx, c.client, userID, o)
+			if err != nil {
+				return nil, nil, "", err
+			}
+			return resp.Tweets, resp.Includes, resp.Meta.nextToken(), nil
+		}, mergeTweetIncludes)
+}
+
+// QuoteTweetsPager returns a Pager over every page of QuoteTweets.
+func (c *Client) QuoteTweetsPager(ctx context.Context, tweetID string, opt ...*QuoteTweetsOption) *Pager[*Tweet, TweetIncludes] {
+	return newPager(ctx, opt, func(o *QuoteTweetsOption) *string { return &o.PaginationToken },
+		func(ctx context.Context, o *QuoteTweetsOption) ([]*Tweet, *TweetIncludes, string, error) {
+			resp, err := quoteTweets(ctx, c.client, tweetID, o)
+			if err != nil {
+				return nil, nil, "", err
+			}
+			return resp.Tweets, resp.Includes, resp.Meta.nextToken(), nil
+		}, mergeTweetIncludes)
+}
+
+// HomeTimelinePager returns a Pager over every page of HomeTimeline.
+func (c *Client) HomeTimelinePager(ctx context.Context, userID string, opt ...*HomeTimelineOption) *Pager[*Tweet, TweetIncludes] {
+	return newPager(ctx, opt, func(o *HomeTimelineOption) *string { return &o.PaginationToken },
+		func(ctx context.Context, o *HomeTimelineOption) ([]*Tweet, *TweetIncludes, string, error) {
+			resp, err := homeTimeline(ctx, c.client, userID, o)
+			if err != nil {
+				return nil, nil, "", err
+			}
+			return resp.Tweets, resp.Includes, resp.Meta.nextToken(), nil
+		}, mergeTweetIncludes)
+}
+
+// UserMentionTimelinePager returns a Pager over every page of UserMentionTimeline.
+func (c *Client) UserMentionTimelinePager(ctx context.Context, userID string, opt ...*UserMentionTimelineOption) *Pager[*Tweet, TweetIncludes] {
+	return newPager(ctx, opt, func(o *UserMentionTimelineOption) *string { return &o.PaginationToken },
+		func(ctx context.Context, o *UserMentionTimelineOption) ([]*Tweet, *TweetIncludes, string, error) {
+			resp, err := userMentionTimeline(ctx, c.client, userID, o)
+			if err != nil {
+				return nil, nil, "", err
+			}
+			return resp.Tweets, resp.Includes, resp.Meta.nextToken(), nil
+		}, mergeTweetIncludes)
+}
+
+// SearchRecentTweetsPager returns a Pager over every page of SearchRecentTweets.
+func (c *Client) SearchRecentTweetsPager(ctx context.Context, tweet string, opt ...*SearchTweetsOption) *Pager[*Tweet, TweetIncludes] {
+	return newPager(ctx, opt, func(o *SearchTweetsOption) *string { return &o.NextToken },
+		func(ctx context.Context, o *SearchTweetsOption) ([]*Tweet, *TweetIncludes, string, error) {
+			resp, err := searchRecentTweets(ctx, c.client, tweet, o)
+			if err != nil {
+				return nil, nil, "", err
+			}
+			return resp.Tweets, resp.Includes, resp.Meta.nextToken(), nil
+		}, mergeTweetIncludes)
+}
+
+// SearchAllTweetsPager returns a Pager over every page of SearchAllTweets.
+func (c *Client) SearchAllTweetsPager(ctx context.Context, tweet string, opt ...*SearchTweetsOption) *Pager[*Tweet, TweetIncludes] {
+	return newPager(ctx, opt, func(o *SearchTweetsOption) *string { return &o.NextToken },
+		func(ctx context.Context, o *SearchTweetsOption) ([]*Tweet, *TweetIncludes, string, error) {
+			resp, err := searchAllTweets(ctx, c.client, tweet, o)
+			if err != nil {
+				return nil, nil, "", err
+			}
+			return resp.Tweets, resp.Includes, resp.Meta.nextToken(), nil
+		}, mergeTweetIncludes)
+}
+
+// LookupUserBookmarksPager returns a Pager over every page of LookupUserBookmarks.
+func (c *Client) LookupUserBookmarksPager(ctx context.Context, userID string, opt ...*LookupUserBookmarksOption) *Pager[*Tweet, TweetIncludes] {
+	return newPager(ctx, opt, func(o *LookupUserBookmarksOption) *string { return &o.PaginationToken },
+		func(ctx context.Context, o *LookupUserBookmarksOption) ([]*Tweet, *TweetIncludes, string, error) {
+			resp, err := lookupUserBookmarks(ctx, c.client, userID, o)
+			if err != nil {
+				return nil, nil, "", err
+			}
+			return resp.Tweets, resp.Includes, resp.Meta.nextToken(), nil
+		}, mergeTweetIncludes)
+}
+
+// TweetsUserLikedPager returns a Pager over every page of TweetsUserLiked.
+func (c *Client) TweetsUserLikedPager(ctx context.Context, userID string, opt ...*TweetsUserLikedOption) *Pager[*Tweet, TweetIncludes] {
+	return newPager(ctx, opt, func(o *TweetsUserLikedOption) *string { return &o.PaginationToken },
+		func(ctx context.Context, o *TweetsUserLikedOption) ([]*Tweet, *TweetIncludes, string, error) {
+			resp, err := tweetsUserLiked(ctx, c.client, userID, o)
+			if err != nil {
+				return nil, nil, "", err
+			}
+			return resp.Tweets, resp.Includes, resp.Meta.nextToken(), nil
+		}, mergeTweetIncludes)
+}
+
+// LookUpListTweetsPager returns a Pager over every page of LookUpListTweets.
+func (c *Client) LookUpListTweetsPager(ctx context.Context, listID string, opt ...*ListTweetsOption) *Pager[*Tweet, TweetIncludes] {
+	return newPager(ctx, opt, func(o *ListTweetsOption) *string { return &o.PaginationToken },
+		func(ctx context.Context, o *ListTweetsOption) ([]*Tweet, *TweetIncludes, string, error) {
+			resp, err := lookUpListTweets(ctx, c.client, listID, o)
+			if err != nil {
+				return nil, nil, "", err
+			}
+			return resp.Tweets, resp.Includes, resp.Meta.nextToken(), nil
+		}, mergeTweetIncludes)
+}
+
+// ListMembersPager returns a Pager over every page of ListMembers.
+func (c *Client) ListMembersPager(ctx context.Context, listID string, opt ...*ListMembersOption) *Pager[*User, ListIncludes] {
+	return newPager(ctx, opt, func(o *ListMembersOption) *string { return &o.PaginationToken },
+		func(ctx context.Context, o *ListMembersOption) ([]*User, *ListIncludes, string, error) {
+			resp, err := listMembers(ctx, c.client, listID, o)
+			if err != nil {
+				return nil, nil, "", err
+			}
+			return resp.Users, resp.Includes, resp.Meta.nextToken(), nil
+		}, mergeTweetIncludes)
+}
+
+// ListFollowersPager returns a Pager over every page of ListFollowers.
+func (c *Client) ListFollowersPager(ctx context.Context, listID string, opt ...*ListFollowersOption) *Pager[*User, ListIncludes] {
+	return newPager(ctx, opt, func(o *ListFollowersOption) *string { return &o.PaginationToken },
+		func(ctx context.Context, o *ListFollowersOption) ([]*User, *ListIncludes, string, error) {
+			resp, err := listFollowers(ctx, c.client, listID, o)
+			if err != nil {
+				return nil, nil, "", err
+			}
+			return resp.Users, resp.Includes, resp.Meta.nextToken(), nil
+		}, mergeTweetIncludes)
+}
+
+// LookUpAllListsOwnedPager returns a Pager over every page of LookUpAllListsOwned.
+func (c *Client) LookUpAllListsOwnedPager(ctx context.Context, userID string, opt ...*AllListsOwnedOption) *Pager[*List, ListIncludes] {
+	return newPager(ctx, opt, func(o *AllListsOwnedOption) *string { return &o.PaginationToken },
+		func(ctx context.Context, o *AllListsOwnedOption) ([]*List, *ListIncludes, string, error) {
+			resp, err := lookUpAllListsOwned(ctx, c.client, userID, o)
+			if err != nil {
+				return nil, nil, "", err
+			}
+			return resp.Lists, resp.Includes, resp.Meta.nextToken(), nil
+		}, mergeTweetIncludes)
+}
+
+// AllListsUserFollowsPager returns a Pager over every page of AllListsUserFollows.
+func (c *Client) AllListsUserFollowsPager(ctx context.Context, userID string, opt ...*ListFollowsOption) *Pager[*List, ListIncludes] {
+	return newPager(ctx, opt, func(o *ListFollowsOption) *string { return &o.PaginationToken },
+		func(ctx context.Context, o *ListFollowsOption) ([]*List, *ListIncludes, string, error) {
+			resp, err := allListsUserFollows(ctx, c.client, userID, o)
+			if err != nil {
+				return nil, nil, "", err
+			}
+			return resp.Lists, resp.Includes, resp.Meta.nextToken(), nil
+		}, mergeTweetIncludes)
+}
+
+// ListsSpecifiedUserPager returns a Pager over every page of ListsSpecifiedUser.
+func (c *Client) ListsSpecifiedUserPager(ctx context.Context, userID string, opt ...*ListsSpecifiedUserOption) *Pager[*List, ListIncludes] {
+	return newPager(ctx, opt, func(o *ListsSpecifiedUserOption) *string { return &o.PaginationToken },
+		func(ctx context.Context, o *ListsSpecifiedUserOption) ([]*List, *ListIncludes, string, error) {
+			resp, err := listsSpecifiedUser(ctx, c.client, userID, o)
+			if err != nil {
+				return nil, nil, "", err
+			}
+			return resp.Lists, resp.Includes, resp.Meta.nextToken(), nil
+		}, mergeTweetIncludes)
+}
+
+// LookUpAllOneToOneDMPager returns a Pager over every page of LookUpAllOneToOneDM.
+func (c *Client) LookUpAllOneToOneDMPager(ctx context.Context, participantID string, opt ...*DirectMessageOption) *Pager[*DirectMessage, DirectMessageIncludes] {
+	return newPager(ctx, opt, func(o *DirectMessageOption) *string { return &o.PaginationToken },
+		func(ctx context.Context, o *DirectMessageOption) ([]*DirectMessage, *DirectMessageIncludes, string, error) {
+			resp, err := lookUpAllOneToOneDM(ctx, c.client, participantID, o)
+			if err != nil {
+				return nil, nil, "", err
+			}
+			return resp.Message, resp.Includes, resp.Meta.nextToken(), nil
+		}, mergeDirectMessageIncludes)
+}
+
+// LookUpDMPager returns a Pager over every page of LookUpDM.
+func (c *Client) LookUpDMPager(ctx context.Context, dmConversationID string, opt ...*DirectMessageOption) *Pager[*DirectMessage, DirectMessageIncludes] {
+	return newPager(ctx, opt, func(o *DirectMessageOption) *string { return &o.PaginationToken },
+		func(ctx context.Context, o *DirectMessageOption) ([]*DirectMessage, *DirectMessageIncludes, string, error) {
+			resp, err := lookUpDM(ctx, c.client, dmConversationID, o)
+			if err != nil {
+				return nil, nil, "", err
+			}
+			return resp.Message, resp.Includes, resp.Meta.nextToken(), nil
+		}, mergeDirectMessageIncludes)
+}
+
+// LookUpAllDMPager returns a Pager over every page of LookUpAllDM.
+func (c *Client) LookUpAllDMPager(ctx context.Context, opt ...*DirectMessageOption) *Pager[*DirectMessage, DirectMessageIncludes] {
+	return newPager(ctx, opt, func(o *DirectMessageOption) *string { return &o.PaginationToken },
+		func(ctx context.Context, o *DirectMessageOption) ([]*DirectMessage, *DirectMessageIncludes, string, error) {
+			resp, err := lookUpAllDM(ctx, c.client, o)
+			if err != nil {
+				return nil, nil, "", err
+			}
+			return resp.Message, resp.Includes, resp.Meta.nextToken(), nil
+		}, mergeDirectMessageIncludes)
+}
+
+// CountAllTweetsPager returns a Pager over every page of CountAllTweets. It has no includes.
+func (c *Client) CountAllTweetsPager(ctx context.Context, tweet string, opt ...*TweetCountsAllOption) *Pager[*TimeseriesCount, struct{}] {
+	return newPager(ctx, opt, func(o *TweetCountsAllOption) *string { return &o.NextToken },
+		func(ctx context.Context, o *TweetCountsAllOption) ([]*TimeseriesCount, *struct{}, string, error) {
+			resp, err := countAllTweets(ctx, c.client, tweet, o)
+			if err != nil {
+				return nil, nil, "", err
+			}
+			return resp.Counts, nil, resp.Meta.nextToken(), nil
+		}, nil)
+}
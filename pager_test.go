@@ -0,0 +1,201 @@
+package gotwtr_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sivchari/gotwtr"
+)
+
+// pagedResponses returns the body keyed by the pagination token found in tokenParam.
+func pagedResponses(t *testing.T, tokenParam string, pages map[string]string) *http.Client {
+	t.Helper()
+	return mockHTTPClient(func(req *http.Request) *http.Response {
+		body, ok := pages[req.URL.Query().Get(tokenParam)]
+		if !ok {
+			return &http.Response{
+				StatusCode: http.StatusBadRequest,
+				Body:       io.NopCloser(strings.NewReader(`{"title":"Invalid Request"}`)),
+			}
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+		}
+	})
+}
+
+var followersPages = map[string]string{
+	"": `{
+		"data": [{"id": "1", "username": "a", "pinned_tweet_id": "10"}, {"id": "2", "username": "b", "pinned_tweet_id": "10"}],
+		"includes": {"tweets": [{"id": "10", "text": "pinned"}]},
+		"meta": {"result_count": 2, "next_token": "page2"}
+	}`,
+	"page2": `{
+		"data": [{"id": "3", "username": "c", "pinned_tweet_id": "10"}, {"id": "4", "username": "d", "pinned_tweet_id": "11"}],
+		"includes": {"tweets": [{"id": "10", "text": "pinned"}, {"id": "11", "text": "another"}]},
+		"meta": {"result_count": 2, "next_token": "page3"}
+	}`,
+	"page3": `{
+		"data": [{"id": "5", "username": "e"}],
+		"meta": {"result_count": 1}
+	}`,
+}
+
+func Test_FollowersPager(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		maxItems      int
+		maxPages      int
+		opt           []*gotwtr.FollowOption
+		wantIDs       []string
+		wantPages     int
+		wantTweets    []string
+		wantNextToken string
+		wantErr       bool
+	}{
+		{
+			name:       "all pages",
+			wantIDs:    []string{"1", "2", "3", "4", "5"},
+			wantPages:  3,
+			wantTweets: []string{"10", "11"},
+		},
+		{
+			name:          "max items truncates the last page",
+			maxItems:      3,
+			wantIDs:       []string{"1", "2", "3"},
+			wantPages:     2,
+			wantTweets:    []string{"10", "11"},
+			wantNextToken: "page2",
+		},
+		{
+			name:          "max items cuts the first page of a resumed iteration",
+			maxItems:      1,
+			opt:           []*gotwtr.FollowOption{{PaginationToken: "page2"}},
+			wantIDs:       []string{"3"},
+			wantPages:     1,
+			wantTweets:    []string{"10", "11"},
+			wantNextToken: "page2",
+		},
+		{
+			name:          "max pages",
+			maxPages:      1,
+			wantIDs:       []string{"1", "2"},
+			wantPages:     1,
+			wantTweets:    []string{"10"},
+			wantNextToken: "page2",
+		},
+		{
+			name:       "resume from a pagination token",
+			opt:        []*gotwtr.FollowOption{{PaginationToken: "page2"}},
+			wantIDs:    []string{"3", "4", "5"},
+			wantPages:  2,
+			wantTweets: []string{"10", "11"},
+		},
+		{
+			name:    "invalid token",
+			opt:     []*gotwtr.FollowOption{{PaginationToken: "unknown"}},
+			wantErr: true,
+		},
+		{
+			name:    "too many options",
+			opt:     []*gotwtr.FollowOption{{}, {}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c := gotwtr.New("key", gotwtr.WithHTTPClient(pagedResponses(t, "pagination_token", followersPages)))
+			p := c.FollowersPager(context.Background(), "2244994945", tt.opt...)
+			p.MaxItems = tt.maxItems
+			p.MaxPages = tt.maxPages
+			var (
+				ids   []string
+				pages int
+			)
+			for p.Next() {
+				pages++
+				for _, u := range p.Page() {
+					ids = append(ids, u.ID)
+				}
+			}
+			if (p.Err() != nil) != tt.wantErr {
+				t.Fatalf("Pager.Err() = %v, wantErr %v", p.Err(), tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if diff := cmp.Diff(tt.wantIDs, ids); diff != "" {
+				t.Errorf("Pager.Page() mismatch (-want +got):\n%s", diff)
+			}
+			if pages != tt.wantPages {
+				t.Errorf("Pager.Next() returned %d pages, want %d", pages, tt.wantPages)
+			}
+			var tweets []string
+			for _, tw := range p.Includes().Tweets {
+				tweets = append(tweets, tw.ID)
+			}
+			if diff := cmp.Diff(tt.wantTweets, tweets); diff != "" {
+				t.Errorf("Pager.Includes() mismatch (-want +got):\n%s", diff)
+			}
+			if got := p.NextToken(); got != tt.wantNextToken {
+				t.Errorf("Pager.NextToken() = %q, want %q", got, tt.wantNextToken)
+			}
+		})
+	}
+}
+
+func Test_SearchRecentTweetsPager(t *testing.T) {
+	t.Parallel()
+	c := gotwtr.New("key", gotwtr.WithHTTPClient(pagedResponses(t, "next_token", map[string]string{
+		"":  `{"data": [{"id": "1", "text": "a"}], "meta": {"result_count": 1, "next_token": "b"}}`,
+		"b": `{"data": [{"id": "2", "text": "b"}], "meta": {"result_count": 1}}`,
+	})))
+	var ids []string
+	err := c.SearchRecentTweetsPager(context.Background(), "from:TwitterDev").ForEach(func(tw *gotwtr.Tweet) error {
+		ids = append(ids, tw.ID)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Pager.ForEach() error = %v", err)
+	}
+	if diff := cmp.Diff([]string{"1", "2"}, ids); diff != "" {
+		t.Errorf("Pager.ForEach() mismatch (-want +got):\n%s", diff)
+	}
+
+	errStop := errors.New("stop")
+	err = c.SearchRecentTweetsPager(context.Background(), "from:TwitterDev").ForEach(func(tw *gotwtr.Tweet) error {
+		return errStop
+	})
+	if !errors.Is(err, errStop) {
+		t.Errorf("Pager.ForEach() error = %v, want %v", err, errStop)
+	}
+}
+
+func Test_CountAllTweetsPager(t *testing.T) {
+	t.Parallel()
+	c := gotwtr.New("key", gotwtr.WithHTTPClient(pagedResponses(t, "next_token", map[string]string{
+		"":  `{"data": [{"start": "2021-05-01T00:00:00.000Z", "end": "2021-05-02T00:00:00.000Z", "tweet_count": 3}], "meta": {"total_tweet_count": 3, "next_token": "b"}}`,
+		"b": `{"data": [{"start": "2021-04-30T00:00:00.000Z", "end": "2021-05-01T00:00:00.000Z", "tweet_count": 4}], "meta": {"total_tweet_count": 4}}`,
+	})))
+	var counts []int
+	err := c.CountAllTweetsPager(context.Background(), "from:TwitterDev").ForEach(func(tc *gotwtr.TimeseriesCount) error {
+		counts = append(counts, tc.TweetCount)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Pager.ForEach() error = %v", err)
+	}
+	if diff := cmp.Diff([]int{3, 4}, counts); diff != "" {
+		t.Errorf("Pager.ForEach() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -19,7 +19,7 @@ type Poll struct {
 	ID              string        `json:"id"`
 	Options         []*PollOption `json:"options"`
 	DurationMinutes int           `json:"duration_minutes,omitempty"`
-	EndDatetime     string        `json:"end_datetime,omitempty"`
+	EndDatetime     Time          `json:"end_datetime,omitempty"`
 	VotingStatus    string        `json:"voting_status,omitempty"`
 }
 
@@ -0,0 +1,314 @@
+package query
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	maxPointRadiusMiles = 25
+	maxPointRadiusKM    = 40
+)
+
+func invalid(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: "+format, append([]interface{}{ErrInvalidOperator}, args...)...)
+}
+
+// term builds an operator whose value is a single word, such as from:TwitterDev.
+func term(prefix, value string, standalone, elevated bool) *operator {
+	op := &operator{
+		text:         prefix + value,
+		isStandalone: standalone,
+		elevated:     elevated,
+	}
+	if value == "" || strings.ContainsAny(value, " \t\r\n\"()") {
+		op.err = invalid("%q is not a valid value for %s", value, prefix)
+	}
+	return op
+}
+
+// quoted builds an operator whose value may hold spaces, such as place:"new york city".
+func quoted(prefix, value string, standalone, elevated bool) *operator {
+	op := &operator{
+		text:         prefix + quote(value),
+		isStandalone: standalone,
+		elevated:     elevated,
+	}
+	if strings.TrimSpace(value) == "" {
+		op.err = invalid("%s requires a value", prefix)
+	}
+	return op
+}
+
+func quote(s string) string {
+	if s != "" && !strings.ContainsAny(s, " \t\r\n\"():") {
+		return s
+	}
+	return `"` + strings.ReplaceAll(s, `"`, `\"`) + `"`
+}
+
+func flag(text string, elevated bool) *operator {
+	return &operator{
+		text:     text,
+		elevated: elevated,
+	}
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// Keyword matches a keyword within the body of a Tweet, e.g. Keyword("pepsi"). Use Phrase for several words.
+func Keyword(word string) Query {
+	return term("", word, true, false)
+}
+
+// Phrase matches the exact phrase within the body of a Tweet.
+func Phrase(phrase string) Query {
+	op := &operator{
+		text:         `"` + strings.ReplaceAll(phrase, `"`, `\"`) + `"`,
+		isStandalone: true,
+	}
+	if strings.TrimSpace(phrase) == "" {
+		op.err = invalid("phrase requires a value")
+	}
+	return op
+}
+
+// Hashtag matches Tweets that contain the hashtag, with or without the leading #.
+func Hashtag(tag string) Query {
+	return term("#", strings.TrimPrefix(tag, "#"), true, false)
+}
+
+// Mention matches Tweets that mention the user name, with or without the leading @.
+func Mention(userName string) Query {
+	return term("@", strings.TrimPrefix(userName, "@"), true, false)
+}
+
+// Cashtag matches Tweets that contain the cashtag, with or without the leading $. It requires elevated access.
+func Cashtag(tag string) Query {
+	return term("$", strings.TrimPrefix(tag, "$"), true, true)
+}
+
+// From matches Tweets sent by the user name or user ID.
+func From(user string) Query {
+	return term("from:", strings.TrimPrefix(user, "@"), true, false)
+}
+
+// To matches Tweets in reply to the user name or user ID.
+func To(user string) Query {
+	return term("to:", strings.TrimPrefix(user, "@"), true, false)
+}
+
+// URL matches Tweets containing a URL that matches url, on its expanded form.
+func URL(url string) Query {
+	return quoted("url:", url, true, false)
+}
+
+// RetweetsOf matches Retweets of the user name or user ID.
+func RetweetsOf(user string) Query {
+	return term("retweets_of:", strings.TrimPrefix(user, "@"), true, false)
+}
+
+// Context matches Tweets annotated with the context domain and entity. entityID may be "*" to match any entity of the domain.
+func Context(domainID, entityID string) Query {
+	op := term("context:", domainID+"."+entityID, true, false)
+	if op.err == nil && (domainID == "" || entityID == "") {
+		op.err = invalid("context requires a domain ID and an entity ID")
+	}
+	return op
+}
+
+// Entity matches Tweets with the entity string value, e.g. Entity("Michael Jordan").
+func Entity(name string) Query {
+	// the value of entity: is always quoted, even when it is a single word.
+	op := &operator{
+		text:         `entity:"` + strings.ReplaceAll(name, `"`, `\"`) + `"`,
+		isStandalone: true,
+	}
+	if strings.TrimSpace(name) == "" {
+		op.err = invalid("entity requires a value")
+	}
+	return op
+}
+
+// ConversationID matches Tweets that share the conversation ID.
+func ConversationID(id string) Query {
+	return term("conversation_id:", id, true, false)
+}
+
+// List matches Tweets posted by the members of the list.
+func List(listID string) Query {
+	return term("list:", listID, true, false)
+}
+
+// Bio matches Tweets of users whose bio contains the keyword or phrase. It requires elevated access.
+func Bio(text string) Query {
+	return quoted("bio:", text, true, true)
+}
+
+// BioName matches Tweets of users whose name contains the keyword. It requires elevated access.
+func BioName(text string) Query {
+	return quoted("bio_name:", text, true, true)
+}
+
+// BioLocation matches Tweets of users whose profile location contains the keyword or phrase. It requires elevated access.
+func BioLocation(text string) Query {
+	return quoted("bio_location:", text, true, true)
+}
+
+// Place matches Tweets tagged with the place name or ID. It requires elevated access.
+func Place(place string) Query {
+	return quoted("place:", place, true, true)
+}
+
+// PlaceCountry matches Tweets tagged with a place in the ISO alpha-2 country. It requires elevated access.
+func PlaceCountry(countryCode string) Query {
+	op := term("place_country:", countryCode, true, true)
+	if op.err == nil && len(countryCode) != 2 {
+		op.err = invalid("%q is not an ISO alpha-2 country code", countryCode)
+	}
+	return op
+}
+
+// PointRadius matches Tweets tagged with a location within radius of the point.
+// radius is a number followed by mi or km, at most 25mi. It requires elevated access.
+func PointRadius(longitude, latitude float64, radius string) Query {
+	op := &operator{
+		text:         "point_radius:[" + formatFloat(longitude) + " " + formatFloat(latitude) + " " + radius + "]",
+		isStandalone: true,
+		elevated:     true,
+	}
+	if err := validateCoordinates(longitude, latitude); err != nil {
+		op.err = err
+		return op
+	}
+	var max float64
+	switch {
+	case strings.HasSuffix(radius, "mi"):
+		max = maxPointRadiusMiles
+	case strings.HasSuffix(radius, "km"):
+		max = maxPointRadiusKM
+	default:
+		op.err = invalid("point_radius radius %q must end with mi or km", radius)
+		return op
+	}
+	r, err := strconv.ParseFloat(radius[:len(radius)-2], 64)
+	if err != nil || r <= 0 || r > max {
+		op.err = invalid("point_radius radius %q must be greater than zero and at most 25mi", radius)
+	}
+	return op
+}
+
+// BoundingBox matches Tweets tagged with a location within the box. It requires elevated access.
+func BoundingBox(westLongitude, southLatitude, eastLongitude, northLatitude float64) Query {
+	op := &operator{
+		text: "bounding_box:[" + formatFloat(westLongitude) + " " + formatFloat(southLatitude) + " " +
+			formatFloat(eastLongitude) + " " + formatFloat(northLatitude) + "]",
+		isStandalone: true,
+		elevated:     true,
+	}
+	switch {
+	case validateCoordinates(westLongitude, southLatitude) != nil:
+		op.err = validateCoordinates(westLongitude, southLatitude)
+	case validateCoordinates(eastLongitude, northLatitude) != nil:
+		op.err = validateCoordinates(eastLongitude, northLatitude)
+	case westLongitude >= eastLongitude || southLatitude >= northLatitude:
+		op.err = invalid("bounding_box corners must be south west and north east")
+	}
+	return op
+}
+
+func validateCoordinates(longitude, latitude float64) error {
+	if longitude < -180 || longitude > 180 || latitude < -90 || latitude > 90 {
+		return invalid("coordinates %v %v are out of range", longitude, latitude)
+	}
+	return nil
+}
+
+// IsRetweet matches Retweets. It must be combined with a standalone operator.
+func IsRetweet() Query {
+	return flag("is:retweet", false)
+}
+
+// IsReply matches replies. It must be combined with a standalone operator.
+func IsReply() Query {
+	return flag("is:reply", false)
+}
+
+// IsQuote matches Quote Tweets. It must be combined with a standalone operator.
+func IsQuote() Query {
+	return flag("is:quote", false)
+}
+
+// IsVerified matches Tweets of verified users. It must be combined with a standalone operator.
+func IsVerified() Query {
+	return flag("is:verified", false)
+}
+
+// IsNullcast matches Tweets created for promotion only. It can only be used negated and requires elevated access.
+func IsNullcast() Query {
+	op := flag("is:nullcast", true)
+	op.negatedOnly = true
+	return op
+}
+
+// HasHashtags matches Tweets that contain a hashtag. It must be combined with a standalone operator.
+func HasHashtags() Query {
+	return flag("has:hashtags", false)
+}
+
+// HasCashtags matches Tweets that contain a cashtag. It must be combined with a standalone operator and requires elevated access.
+func HasCashtags() Query {
+	return flag("has:cashtags", true)
+}
+
+// HasLinks matches Tweets that contain a link or media. It must be combined with a standalone operator.
+func HasLinks() Query {
+	return flag("has:links", false)
+}
+
+// HasMentions matches Tweets that mention another user. It must be combined with a standalone operator.
+func HasMentions() Query {
+	return flag("has:mentions", false)
+}
+
+// HasMedia matches Tweets that contain a photo, GIF or video. It must be combined with a standalone operator.
+func HasMedia() Query {
+	return flag("has:media", false)
+}
+
+// HasImages matches Tweets that contain an image. It must be combined with a standalone operator.
+func HasImages() Query {
+	return flag("has:images", false)
+}
+
+// HasVideoLink matches Tweets that contain a native Twitter video. It must be combined with a standalone operator.
+func HasVideoLink() Query {
+	return flag("has:video_link", false)
+}
+
+// HasGeo matches Tweets that have geolocation data. It must be combined with a standalone operator and requires elevated access.
+func HasGeo() Query {
+	return flag("has:geo", true)
+}
+
+// Lang matches Tweets classified in the BCP 47 language, e.g. Lang("ja"). It must be combined with a standalone operator.
+func Lang(code string) Query {
+	op := term("lang:", code, false, false)
+	if op.err == nil && (len(code) < 2 || len(code) > 7) {
+		op.err = invalid("%q is not a BCP 47 language identifier", code)
+	}
+	return op
+}
+
+// Sample matches a random sample of percent percent of the Tweets. It must be combined with a standalone operator
+// and requires elevated access.
+func Sample(percent int) Query {
+	op := flag("sample:"+strconv.Itoa(percent), true)
+	if percent < 1 || percent > 100 {
+		op.err = invalid("sample percent %d must be between 1 and 100", percent)
+	}
+	return op
+}
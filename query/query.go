@@ -0,0 +1,225 @@
+// Package query builds search queries and filtered stream rules for the Twitter v2 API.
+//
+// Queries are composed from operators with And, Or and Not, and validated by Build before any request is sent:
+//
+//	q := query.And(query.From("TwitterDev"), query.HasMedia(), query.Not(query.IsRetweet()))
+//	s, err := query.Build(q) // from:TwitterDev has:media -is:retweet
+//
+// FYI https://developer.twitter.com/en/docs/twitter-api/tweets/search/integrate/build-a-query
+package query
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/sivchari/gotwtr"
+)
+
+const (
+	// DefaultMaxLength is the maximum length of queries of the recent search and of stream rules.
+	DefaultMaxLength = 512
+	// ElevatedMaxLength is the maximum length of queries of the full-archive search and of stream rules with elevated access.
+	ElevatedMaxLength = 1024
+)
+
+var (
+	// ErrTooLong is returned when a query exceeds its maximum length.
+	ErrTooLong = errors.New("query is too long")
+	// ErrNoStandaloneOperator is returned when a query only holds operators which must be combined with a standalone one.
+	ErrNoStandaloneOperator = errors.New("query needs at least one standalone operator")
+	// ErrElevatedOperator is returned when a query uses an operator which requires elevated access without allowing it.
+	ErrElevatedOperator = errors.New("operator requires elevated access")
+	// ErrInvalidOperator is returned when an operator is malformed or misused.
+	ErrInvalidOperator = errors.New("invalid operator")
+)
+
+// Query is an operator or a combination of operators.
+type Query interface {
+	// String returns the query as Twitter expects it. It does not validate the query, use Build for that.
+	String() string
+	validate(b *BuildOption, negated bool) error
+	standalone() bool
+}
+
+// BuildOption configures the validation of Build.
+type BuildOption struct {
+	// MaxLength is the maximum length of the query. Zero means DefaultMaxLength.
+	MaxLength int
+	// Elevated allows the operators which are only available with elevated or academic research access.
+	Elevated bool
+}
+
+// Build validates q and returns it as a string.
+func Build(q Query, opt ...*BuildOption) (string, error) {
+	var bopt BuildOption
+	switch len(opt) {
+	case 0:
+		// do nothing
+	case 1:
+		bopt = *opt[0]
+	default:
+		return "", errors.New("query: only one option is allowed")
+	}
+	if bopt.MaxLength == 0 {
+		bopt.MaxLength = DefaultMaxLength
+	}
+	if q == nil {
+		return "", fmt.Errorf("query: %w: empty query", ErrInvalidOperator)
+	}
+	if err := q.validate(&bopt, false); err != nil {
+		return "", fmt.Errorf("query: %w", err)
+	}
+	if !q.standalone() {
+		return "", fmt.Errorf("query: %w", ErrNoStandaloneOperator)
+	}
+	s := q.String()
+	if n := utf8.RuneCountInString(s); n > bopt.MaxLength {
+		return "", fmt.Errorf("query: %w: %d characters, at most %d are allowed", ErrTooLong, n, bopt.MaxLength)
+	}
+	return s, nil
+}
+
+// Rule builds a filtered stream rule from q. Rules have the same limits as queries.
+func Rule(q Query, tag string, opt ...*BuildOption) (*gotwtr.AddRule, error) {
+	value, err := Build(q, opt...)
+	if err != nil {
+		return nil, err
+	}
+	return &gotwtr.AddRule{
+		Value: value,
+		Tag:   tag,
+	}, nil
+}
+
+// operator is a single operator such as from:TwitterDev.
+type operator struct {
+	text string
+	// isStandalone operators can be used alone, the others must be combined with a standalone operator.
+	isStandalone bool
+	elevated     bool
+	// negatedOnly operators can only be used negated, such as is:nullcast.
+	negatedOnly bool
+	err         error
+}
+
+func (o *operator) String() string {
+	return o.text
+}
+
+func (o *operator) validate(b *BuildOption, negated bool) error {
+	switch {
+	case o.err != nil:
+		return o.err
+	case o.elevated && !b.Elevated:
+		return fmt.Errorf("%w: %s", ErrElevatedOperator, o.text)
+	case o.negatedOnly && !negated:
+		return fmt.Errorf("%w: %s can only be used negated", ErrInvalidOperator, o.text)
+	}
+	return nil
+}
+
+func (o *operator) standalone() bool {
+	return o.isStandalone
+}
+
+type groupKind int
+
+const (
+	and groupKind = iota
+	or
+)
+
+type group struct {
+	kind     groupKind
+	children []Query
+}
+
+// And matches Tweets which match every query of qs.
+func And(qs ...Query) Query {
+	return &group{kind: and, children: qs}
+}
+
+// Or matches Tweets which match at least one query of qs.
+func Or(qs ...Query) Query {
+	return &group{kind: or, children: qs}
+}
+
+func (g *group) String() string {
+	if len(g.children) == 1 {
+		return g.children[0].String()
+	}
+	sep := " "
+	if g.kind == or {
+		sep = " OR "
+	}
+	parts := make([]string, 0, len(g.children))
+	for _, q := range g.children {
+		s := q.String()
+		if c, ok := q.(*group); ok && c.kind != g.kind && len(c.children) > 1 {
+			s = "(" + s + ")"
+		}
+		parts = append(parts, s)
+	}
+	return strings.Join(parts, sep)
+}
+
+func (g *group) validate(b *BuildOption, negated bool) error {
+	if len(g.children) == 0 {
+		return fmt.Errorf("%w: empty group", ErrInvalidOperator)
+	}
+	for _, q := range g.children {
+		if q == nil {
+			return fmt.Errorf("%w: empty query", ErrInvalidOperator)
+		}
+		if err := q.validate(b, negated); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// standalone reports whether g can stand alone: one standalone operator is enough to anchor a conjunction,
+// while every alternative of a disjunction must stand alone.
+func (g *group) standalone() bool {
+	for _, q := range g.children {
+		s := q.standalone()
+		if g.kind == and && s {
+			return true
+		}
+		if g.kind == or && !s {
+			return false
+		}
+	}
+	return g.kind == or
+}
+
+type not struct {
+	q Query
+}
+
+// Not matches Tweets which do not match q.
+func Not(q Query) Query {
+	return &not{q: q}
+}
+
+func (n *not) String() string {
+	s := n.q.String()
+	if g, ok := n.q.(*group); ok && len(g.children) > 1 {
+		s = "(" + s + ")"
+	}
+	return "-" + s
+}
+
+func (n *not) validate(b *BuildOption, negated bool) error {
+	if n.q == nil {
+		return fmt.Errorf("%w: empty query", ErrInvalidOperator)
+	}
+	return n.q.validate(b, !negated)
+}
+
+// standalone is always false, because a negated operator can not anchor a query.
+func (n *not) standalone() bool {
+	return false
+}
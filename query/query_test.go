@@ -0,0 +1,141 @@
+package query_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sivchari/gotwtr"
+	"github.com/sivchari/gotwtr/query"
+)
+
+func TestBuild(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		q       query.Query
+		opt     []*query.BuildOption
+		want    string
+		wantErr error
+	}{
+		{
+			name: "conjunction",
+			q:    query.And(query.From("@TwitterDev"), query.HasMedia(), query.Not(query.IsRetweet())),
+			want: "from:TwitterDev has:media -is:retweet",
+		},
+		{
+			name: "grouping",
+			q: query.And(
+				query.Or(query.Hashtag("golang"), query.Keyword("gopher")),
+				query.Lang("ja"),
+				query.Not(query.Or(query.IsReply(), query.IsQuote())),
+			),
+			want: "(#golang OR gopher) lang:ja -(is:reply OR is:quote)",
+		},
+		{
+			name: "nested and inside or",
+			q:    query.Or(query.And(query.From("a"), query.HasLinks()), query.Mention("b")),
+			want: "(from:a has:links) OR @b",
+		},
+		{
+			name: "quoting",
+			q:    query.And(query.Phrase(`say "hi"`), query.Entity("Michael Jordan"), query.URL("https://developer.twitter.com")),
+			want: `"say \"hi\"" entity:"Michael Jordan" url:"https://developer.twitter.com"`,
+		},
+		{
+			name: "context",
+			q:    query.Context("10", "799022225751871488"),
+			want: "context:10.799022225751871488",
+		},
+		{
+			name: "elevated operators",
+			q: query.And(
+				query.Or(query.PointRadius(-105.27346517, 40.01924738, "10mi"), query.BoundingBox(-105.301758, 39.964069, -105.178505, 40.09455)),
+				query.Not(query.IsNullcast()),
+				query.Sample(10),
+			),
+			opt:  []*query.BuildOption{{Elevated: true}},
+			want: "(point_radius:[-105.27346517 40.01924738 10mi] OR bounding_box:[-105.301758 39.964069 -105.178505 40.09455]) -is:nullcast sample:10",
+		},
+		{
+			name:    "elevated operator without access",
+			q:       query.And(query.Keyword("coffee"), query.HasGeo()),
+			wantErr: query.ErrElevatedOperator,
+		},
+		{
+			name:    "only conjunction-required operators",
+			q:       query.And(query.HasMedia(), query.Lang("en")),
+			wantErr: query.ErrNoStandaloneOperator,
+		},
+		{
+			name:    "negated standalone operator",
+			q:       query.And(query.Not(query.From("TwitterDev")), query.HasMedia()),
+			wantErr: query.ErrNoStandaloneOperator,
+		},
+		{
+			name:    "or with a conjunction-required alternative",
+			q:       query.Or(query.Keyword("cat"), query.HasMedia()),
+			wantErr: query.ErrNoStandaloneOperator,
+		},
+		{
+			name:    "nullcast must be negated",
+			q:       query.And(query.Keyword("cat"), query.IsNullcast()),
+			opt:     []*query.BuildOption{{Elevated: true}},
+			wantErr: query.ErrInvalidOperator,
+		},
+		{
+			name:    "keyword with spaces",
+			q:       query.Keyword("two words"),
+			wantErr: query.ErrInvalidOperator,
+		},
+		{
+			name:    "radius too large",
+			q:       query.PointRadius(0, 0, "30mi"),
+			opt:     []*query.BuildOption{{Elevated: true}},
+			wantErr: query.ErrInvalidOperator,
+		},
+		{
+			name:    "empty group",
+			q:       query.And(),
+			wantErr: query.ErrInvalidOperator,
+		},
+		{
+			name:    "too long",
+			q:       query.Phrase(strings.Repeat("a", 511)),
+			wantErr: query.ErrTooLong,
+		},
+		{
+			name: "elevated length",
+			q:    query.Phrase(strings.Repeat("a", 511)),
+			opt:  []*query.BuildOption{{MaxLength: query.ElevatedMaxLength}},
+			want: `"` + strings.Repeat("a", 511) + `"`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := query.Build(tt.q, tt.opt...)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("query.Build() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("query.Build() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRule(t *testing.T) {
+	t.Parallel()
+	got, err := query.Rule(query.And(query.Keyword("cat"), query.HasImages()), "cats with images")
+	if err != nil {
+		t.Fatalf("query.Rule() error = %v", err)
+	}
+	want := &gotwtr.AddRule{Value: "cat has:images", Tag: "cats with images"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("query.Rule() mismatch (-want +got):\n%s", diff)
+	}
+}
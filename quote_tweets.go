@@ -0,0 +1,56 @@
+package gotwtr
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+func quoteTweets(ctx context.Context, c *client, tweetID string, opt ...*QuoteTweetsOption) (*QuoteTweetsResponse, error) {
+	if tweetID == "" {
+		return nil, errors.New("quote tweets: tweetID parameter is required")
+	}
+	ep := c.baseURL + fmt.Sprintf(quoteTweetsURL, tweetID)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ep, nil)
+	if err != nil {
+		return nil, fmt.Errorf("quote tweets new request with ctx: %w", err)
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.bearerToken))
+
+	var qopt QuoteTweetsOption
+	switch len(opt) {
+	case 0:
+		// do nothing
+	case 1:
+		qopt = *opt[0]
+	default:
+		return nil, errors.New("quote tweets: only one option is allowed")
+	}
+	const (
+		minimumMaxResults = 10
+		maximumMaxResults = 100
+	)
+	if qopt.MaxResults != 0 && (qopt.MaxResults < minimumMaxResults || qopt.MaxResults > maximumMaxResults) {
+		return nil, fmt.Errorf("quote tweets: max results must be between %d and %d", minimumMaxResults, maximumMaxResults)
+	}
+	qopt.addQuery(req)
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("quote tweets response: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var quoteTweets QuoteTweetsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&quoteTweets); err != nil {
+		return nil, fmt.Errorf("quote tweets decode: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return &quoteTweets, newAPIError("quote tweets", req, resp)
+	}
+
+	return &quoteTweets, nil
+}
@@ -0,0 +1,191 @@
+package gotwtr_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sivchari/gotwtr"
+)
+
+func Test_quoteTweets(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		ctx     context.Context
+		client  *http.Client
+		tweetID string
+		opt     []*gotwtr.QuoteTweetsOption
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *gotwtr.QuoteTweetsResponse
+		wantErr bool
+	}{
+		{
+			name: "200 success",
+			args: args{
+				ctx: context.Background(),
+				client: mockHTTPClient(func(req *http.Request) *http.Response {
+					wantQuery := "exclude=retweets&expansions=author_id&max_results=10&user.fields=username"
+					if req.URL.RawQuery != wantQuery {
+						t.Errorf("the query is not correct got %s want %s", req.URL.RawQuery, wantQuery)
+					}
+					body := `{
+						"data": [
+							{
+								"id": "1409931481552543749",
+								"text": "Cool new features! https://t.co/uH4cSMq8G3",
+								"author_id": "2244994945"
+							},
+							{
+								"id": "1409758657045733378",
+								"text": "Looking forward to this 🙌 https://t.co/hvtGqjkWYd",
+								"author_id": "6253282"
+							}
+						],
+						"includes": {
+							"users": [
+								{
+									"id": "2244994945",
+									"name": "Twitter Dev",
+									"username": "TwitterDev"
+								},
+								{
+									"id": "6253282",
+									"name": "Twitter API",
+									"username": "TwitterAPI"
+								}
+							]
+						},
+						"meta": {
+							"result_count": 2,
+							"next_token": "axdnb97jpwj4"
+						}
+					}`
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       io.NopCloser(strings.NewReader(body)),
+					}
+				}),
+				tweetID: "1409931481552543749",
+				opt: []*gotwtr.QuoteTweetsOption{
+					{
+						Exclude:    []gotwtr.Exclude{gotwtr.ExcludeRetweets},
+						Expansions: []gotwtr.Expansion{gotwtr.ExpansionAuthorID},
+						MaxResults: 10,
+						UserFields: []gotwtr.UserField{gotwtr.UserFieldUserName},
+					},
+				},
+			},
+			want: &gotwtr.QuoteTweetsResponse{
+				Tweets: []*gotwtr.Tweet{
+					{
+						ID:       "1409931481552543749",
+						Text:     "Cool new features! https://t.co/uH4cSMq8G3",
+						AuthorID: "2244994945",
+					},
+					{
+						ID:       "1409758657045733378",
+						Text:     "Looking forward to this 🙌 https://t.co/hvtGqjkWYd",
+						AuthorID: "6253282",
+					},
+				},
+				Includes: &gotwtr.TweetIncludes{
+					Users: []*gotwtr.User{
+						{
+							ID:       "2244994945",
+							Name:     "Twitter Dev",
+							UserName: "TwitterDev",
+						},
+						{
+							ID:       "6253282",
+							Name:     "Twitter API",
+							UserName: "TwitterAPI",
+						},
+					},
+				},
+				Meta: &gotwtr.QuoteTweetsMeta{
+					ResultCount: 2,
+					NextToken:   "axdnb97jpwj4",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "404 not found",
+			args: args{
+				ctx: context.Background(),
+				client: mockHTTPClient(func(req *http.Request) *http.Response {
+					body := `{
+						"errors":[
+							{
+								"value":"11111111111111111",
+								"detail":"Could not find tweet with id: [11111111111111111].",
+								"title":"Not Found Error",
+								"resource_type":"tweet",
+								"parameter":"id",
+								"resource_id":"11111111111111111",
+								"type":"https://api.twitter.com/2/problems/resource-not-found"
+							}
+						]
+					}`
+					return &http.Response{
+						StatusCode: http.StatusNotFound,
+						Body:       io.NopCloser(strings.NewReader(body)),
+					}
+				}),
+				tweetID: "11111111111111111",
+			},
+			want: &gotwtr.QuoteTweetsResponse{
+				Tweets: nil,
+				Errors: []*gotwtr.APIResponseError{
+					{
+						Value:        "11111111111111111",
+						Detail:       "Could not find tweet with id: [11111111111111111].",
+						Title:        "Not Found Error",
+						ResourceType: "tweet",
+						Parameter:    "id",
+						ResourceID:   "11111111111111111",
+						Type:         "https://api.twitter.com/2/problems/resource-not-found",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "max results out of range",
+			args: args{
+				ctx: context.Background(),
+				client: mockHTTPClient(func(req *http.Request) *http.Response {
+					t.Error("no request must be sent")
+					return nil
+				}),
+				tweetID: "1409931481552543749",
+				opt:     []*gotwtr.QuoteTweetsOption{{MaxResults: 5}},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c := gotwtr.New("test-key", gotwtr.WithHTTPClient(tt.args.client))
+			got, err := c.QuoteTweets(tt.args.ctx, tt.args.tweetID, tt.args.opt...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("client.QuoteTweets() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("client.QuoteTweets() mismatch (-want +got):\n%s", diff)
+				return
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package gotwtr
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	rateLimitLimitHeader     = "x-rate-limit-limit"
+	rateLimitRemainingHeader = "x-rate-limit-remaining"
+	rateLimitResetHeader     = "x-rate-limit-reset"
+)
+
+// RateLimitPolicy decides what the client does once the rate limit window of an endpoint is exhausted.
+type RateLimitPolicy int
+
+const (
+	// RateLimitPolicyNone only tracks rate limits. Requests are always sent and a 429 response is returned as is.
+	RateLimitPolicyNone RateLimitPolicy = iota
+	// RateLimitPolicyWait blocks until the window resets, or the context is done, and then sends the request.
+	RateLimitPolicyWait
+	// RateLimitPolicyFailFast returns a *RateLimitError without sending the request until the window resets.
+	RateLimitPolicyFailFast
+)
+
+// RateLimit is the rate limit window of an endpoint as reported by the x-rate-limit-* response headers.
+type RateLimit struct {
+	Limit     int
+	Remaining int
+	Reset     time.Time
+}
+
+// RateLimitError is returned when the rate limit window of an endpoint is exhausted.
+type RateLimitError struct {
+	Endpoint string
+	Limit    int
+	Reset    time.Time
+}
+
+func (e *RateLimitError) Error() string {
+	return e.Endpoint + ": rate limit exceeded, resets at " + e.Reset.Format(time.RFC3339)
+}
+
+// Is reports whether target is ErrRateLimited.
+func (e *RateLimitError) Is(target error) bool {
+	return target == ErrRateLimited
+}
+
+// WithRateLimitPolicy sets how the client behaves when an endpoint runs out of requests.
+func WithRateLimitPolicy(policy RateLimitPolicy) ClientOption {
+	return func(c *client) {
+		c.rateLimitPolicy = policy
+	}
+}
+
+type rateLimits struct {
+	mu      sync.Mutex
+	windows map[string]*RateLimit
+}
+
+func (r *rateLimits) get(endpoint string) (RateLimit, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	w, ok := r.windows[endpoint]
+	if !ok {
+		return RateLimit{}, false
+	}
+	return *w, true
+}
+
+// update records the window reported by resp. Responses without rate limit headers are ignored.
+func (r *rateLimits) update(endpoint string, resp *http.Response) (RateLimit, bool) {
+	reset, err := strconv.ParseInt(resp.Header.Get(rateLimitResetHeader), 10, 64)
+	if err != nil {
+		return RateLimit{}, false
+	}
+	w := &RateLimit{
+		Reset: time.Unix(reset, 0),
+	}
+	w.Limit, _ = strconv.Atoi(resp.Header.Get(rateLimitLimitHeader))
+	w.Remaining, _ = strconv.Atoi(resp.Header.Get(rateLimitRemainingHeader))
+	if resp.StatusCode == http.StatusTooManyRequests {
+		w.Remaining = 0
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.windows == nil {
+		r.windows = make(map[string]*RateLimit)
+	}
+	r.windows[endpoint] = w
+	return *w, true
+}
+
+func (r *rateLimits) snapshot() map[string]RateLimit {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	m := make(map[string]RateLimit, len(r.windows))
+	for k, w := range r.windows {
+		m[k] = *w
+	}
+	return m
+}
+
+// RateLimits returns the last known rate limit window of every endpoint called so far.
+// Keys have the form "GET /2/users/:id/followers".
+func (c *client) RateLimits() map[string]RateLimit {
+	return c.rateLimits.snapshot()
+}
+
+// rateLimitKey identifies the endpoint of req. Path segments holding IDs or user names are replaced by placeholders,
+// because Twitter applies rate limits per endpoint rather than per resource.
+func rateLimitKey(req *http.Request) string {
+	segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	for i, s := range segments {
+		switch {
+		case i > 0 && segments[i-1] == "username":
+			segments[i] = ":username"
+		case i > 0 && s != "" && '0' <= s[0] && s[0] <= '9':
+			segments[i] = ":id"
+		}
+	}
+	return req.Method + " /" + strings.Join(segments, "/")
+}
+
+// waitRateLimit applies the rate limit policy before a request to endpoint is sent.
+func (c *client) waitRateLimit(req *http.Request, endpoint string) error {
+	if c.rateLimitPolicy == RateLimitPolicyNone {
+		return nil
+	}
+	w, ok := c.rateLimits.get(endpoint)
+	if !ok || w.Remaining > 0 || !time.Now().Before(w.Reset) {
+		return nil
+	}
+	if c.rateLimitPolicy == RateLimitPolicyFailFast {
+		return &RateLimitError{
+			Endpoint: endpoint,
+			Limit:    w.Limit,
+			Reset:    w.Reset,
+		}
+	}
+	return sleepUntil(req.Context(), w.Reset)
+}
+
+func sleepUntil(ctx context.Context, t time.Time) error {
+	timer := time.NewTimer(time.Until(t))
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
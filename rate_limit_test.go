@@ -0,0 +1,140 @@
+package gotwtr_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sivchari/gotwtr"
+)
+
+func rateLimitedResponse(status int, remaining int, reset time.Time) *http.Response {
+	h := http.Header{}
+	h.Set("x-rate-limit-limit", "15")
+	h.Set("x-rate-limit-remaining", strconv.Itoa(remaining))
+	h.Set("x-rate-limit-reset", strconv.FormatInt(reset.Unix(), 10))
+	return &http.Response{
+		StatusCode: status,
+		Status:     http.StatusText(status),
+		Header:     h,
+		Body:       io.NopCloser(strings.NewReader(`{}`)),
+	}
+}
+
+func Test_RateLimits(t *testing.T) {
+	t.Parallel()
+	reset := time.Unix(time.Now().Add(15*time.Minute).Unix(), 0)
+	c := gotwtr.New("key", gotwtr.WithHTTPClient(mockHTTPClient(func(req *http.Request) *http.Response {
+		return rateLimitedResponse(http.StatusOK, 14, reset)
+	})))
+	if _, err := c.Followers(context.Background(), "2244994945"); err != nil {
+		t.Fatalf("client.Followers() error = %v", err)
+	}
+	if _, err := c.RetrieveSingleUserWithUserName(context.Background(), "TwitterDev"); err != nil {
+		t.Fatalf("client.RetrieveSingleUserWithUserName() error = %v", err)
+	}
+	want := map[string]gotwtr.RateLimit{
+		"GET /2/users/:id/followers":         {Limit: 15, Remaining: 14, Reset: reset},
+		"GET /2/users/by/username/:username": {Limit: 15, Remaining: 14, Reset: reset},
+	}
+	if diff := cmp.Diff(want, c.RateLimits()); diff != "" {
+		t.Errorf("client.RateLimits() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func Test_RateLimitPolicy(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name         string
+		policy       gotwtr.RateLimitPolicy
+		reset        time.Duration
+		timeout      time.Duration
+		calls        int
+		wantRequests int32
+		wantErr      func(err error) bool
+	}{
+		{
+			name:         "none returns the 429 response",
+			policy:       gotwtr.RateLimitPolicyNone,
+			reset:        time.Hour,
+			timeout:      time.Second,
+			calls:        2,
+			wantRequests: 2,
+			wantErr: func(err error) bool {
+				var e *gotwtr.HTTPError
+				return errors.As(err, &e)
+			},
+		},
+		{
+			name:         "fail fast stops sending requests",
+			policy:       gotwtr.RateLimitPolicyFailFast,
+			reset:        time.Hour,
+			timeout:      time.Second,
+			calls:        2,
+			wantRequests: 1,
+			wantErr: func(err error) bool {
+				var e *gotwtr.RateLimitError
+				return errors.As(err, &e) && e.Endpoint == "POST /2/users/:id/following"
+			},
+		},
+		{
+			name:         "wait respects the context",
+			policy:       gotwtr.RateLimitPolicyWait,
+			reset:        time.Hour,
+			timeout:      50 * time.Millisecond,
+			calls:        1,
+			wantRequests: 1,
+			wantErr: func(err error) bool {
+				return errors.Is(err, context.DeadlineExceeded)
+			},
+		},
+		{
+			name:         "wait sends the request again after reset",
+			policy:       gotwtr.RateLimitPolicyWait,
+			reset:        time.Second,
+			timeout:      5 * time.Second,
+			calls:        1,
+			wantRequests: 2,
+			wantErr: func(err error) bool {
+				return err == nil
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var requests int32
+			reset := time.Now().Add(tt.reset)
+			c := gotwtr.New("key",
+				gotwtr.WithRateLimitPolicy(tt.policy),
+				gotwtr.WithHTTPClient(mockHTTPClient(func(req *http.Request) *http.Response {
+					if n := atomic.AddInt32(&requests, 1); n > 1 && tt.policy == gotwtr.RateLimitPolicyWait {
+						return rateLimitedResponse(http.StatusOK, 14, reset.Add(15*time.Minute))
+					}
+					return rateLimitedResponse(http.StatusTooManyRequests, 0, reset)
+				})),
+			)
+			ctx, cancel := context.WithTimeout(context.Background(), tt.timeout)
+			defer cancel()
+			var err error
+			for i := 0; i < tt.calls; i++ {
+				_, err = c.PostFollowing(ctx, "2244994945", "6253282")
+			}
+			if !tt.wantErr(err) {
+				t.Errorf("client.PostFollowing() unexpected error = %v", err)
+			}
+			if got := atomic.LoadInt32(&requests); got != tt.wantRequests {
+				t.Errorf("client.PostFollowing() sent %d requests, want %d", got, tt.wantRequests)
+			}
+		})
+	}
+}
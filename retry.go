@@ -0,0 +1,177 @@
+package gotwtr
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+const (
+	defaultRetryMaxAttempts = 3
+	defaultRetryBaseDelay   = 500 * time.Millisecond
+	defaultRetryMaxDelay    = 30 * time.Second
+)
+
+// RetryPolicy configures how failed requests are retried with exponential backoff.
+//
+// GET, PUT and DELETE requests are always eligible, as are the POST endpoints which only toggle a relationship
+// such as PostFollowing, PostUsersLikingTweet or BookmarkTweet.
+// POST endpoints which create a new resource, such as PostTweet or AddOrDeleteRules, are only retried with RetryUnsafeWrites,
+// because a request that failed after reaching Twitter may have been applied.
+type RetryPolicy struct {
+	// MaxAttempts is the number of attempts including the first one. Zero means 3.
+	MaxAttempts int
+	// BaseDelay is the delay before the first retry. It doubles on every attempt. Zero means 500ms.
+	BaseDelay time.Duration
+	// MaxDelay caps the delay between attempts. Zero means 30s.
+	MaxDelay time.Duration
+	// Jitter is the fraction of each delay, between 0 and 1, which is randomized to spread retries of concurrent callers.
+	Jitter float64
+	// RetryableStatuses are the response status codes that are retried. Nil means 500, 502, 503 and 504.
+	RetryableStatuses []int
+	// RetryUnsafeWrites enables retries of POST endpoints which are not safe to repeat.
+	RetryUnsafeWrites bool
+}
+
+// WithRetryPolicy enables retries of failed requests. Network errors and the retryable statuses of policy are retried.
+func WithRetryPolicy(policy *RetryPolicy) ClientOption {
+	return func(c *client) {
+		p := *policy
+		if p.MaxAttempts == 0 {
+			p.MaxAttempts = defaultRetryMaxAttempts
+		}
+		if p.BaseDelay == 0 {
+			p.BaseDelay = defaultRetryBaseDelay
+		}
+		if p.MaxDelay == 0 {
+			p.MaxDelay = defaultRetryMaxDelay
+		}
+		if p.RetryableStatuses == nil {
+			p.RetryableStatuses = []int{
+				http.StatusInternalServerError,
+				http.StatusBadGateway,
+				http.StatusServiceUnavailable,
+				http.StatusGatewayTimeout,
+			}
+		}
+		c.retryPolicy = &p
+	}
+}
+
+// idempotentWrites are the POST endpoints whose effect does not change when they are repeated.
+var idempotentWrites = map[string]bool{
+	"POST /2/users/:id/blocking":       true,
+	"POST /2/users/:id/bookmarks":      true,
+	"POST /2/users/:id/followed_lists": true,
+	"POST /2/users/:id/following":      true,
+	"POST /2/users/:id/likes":          true,
+	"POST /2/users/:id/muting":         true,
+	"POST /2/users/:id/pinned_lists":   true,
+	"POST /2/users/:id/retweets":       true,
+	"POST /2/lists/:id/members":        true,
+}
+
+func (p *RetryPolicy) allows(method, endpoint string) bool {
+	if p == nil || p.MaxAttempts <= 1 {
+		return false
+	}
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+	case http.MethodPost:
+		return p.RetryUnsafeWrites || idempotentWrites[endpoint]
+	default:
+		return false
+	}
+}
+
+func (p *RetryPolicy) shouldRetry(attempt int, resp *http.Response, err error) bool {
+	if attempt >= p.MaxAttempts {
+		return false
+	}
+	if err != nil {
+		var rerr *RateLimitError
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded) && !errors.As(err, &rerr)
+	}
+	for _, status := range p.RetryableStatuses {
+		if resp.StatusCode == status {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff returns the delay before the retry following attempt.
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	d := time.Duration(float64(p.BaseDelay) * math.Pow(2, float64(attempt-1)))
+	if d > p.MaxDelay || d <= 0 {
+		d = p.MaxDelay
+	}
+	if p.Jitter > 0 {
+		d -= time.Duration(rand.Float64() * math.Min(p.Jitter, 1) * float64(d))
+	}
+	return d
+}
+
+// bufferBody makes the body of req readable more than once.
+func bufferBody(req *http.Request) error {
+	if req.Body == nil || req.GetBody != nil {
+		return nil
+	}
+	b, err := io.ReadAll(req.Body)
+	if err != nil {
+		return err
+	}
+	req.Body.Close()
+	req.Body = io.NopCloser(bytes.NewReader(b))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(b)), nil
+	}
+	return nil
+}
+
+// rewindBody resets the body of req before it is sent again.
+func rewindBody(req *http.Request) error {
+	if req.GetBody == nil {
+		return nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return fmt.Errorf("rewind request body: %w", err)
+	}
+	req.Body = body
+	return nil
+}
+
+// doWithRetry sends req through send and retries it according to the retry policy of c.
+func (c *client) doWithRetry(req *http.Request, endpoint string, send func() (*http.Response, error)) (*http.Response, error) {
+	p := c.retryPolicy
+	if !p.allows(req.Method, endpoint) {
+		return send()
+	}
+	if err := bufferBody(req); err != nil {
+		return nil, fmt.Errorf("buffer request body: %w", err)
+	}
+	for attempt := 1; ; attempt++ {
+		resp, err := send()
+		if !p.shouldRetry(attempt, resp, err) {
+			return resp, err
+		}
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		if err := sleepUntil(req.Context(), time.Now().Add(p.backoff(attempt))); err != nil {
+			return nil, err
+		}
+		if err := rewindBody(req); err != nil {
+			return nil, err
+		}
+	}
+}
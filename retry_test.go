@@ -0,0 +1,146 @@
+package gotwtr_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sivchari/gotwtr"
+)
+
+func Test_RetryPolicy(t *testing.T) {
+	t.Parallel()
+	postTweet := func(ctx context.Context, c *gotwtr.Client) error {
+		_, err := c.PostTweet(ctx, &gotwtr.PostTweetOption{Text: "Hello World"})
+		return err
+	}
+	postFollowing := func(ctx context.Context, c *gotwtr.Client) error {
+		_, err := c.PostFollowing(ctx, "2244994945", "6253282")
+		return err
+	}
+	followers := func(ctx context.Context, c *gotwtr.Client) error {
+		_, err := c.Followers(ctx, "2244994945")
+		return err
+	}
+	tests := []struct {
+		name         string
+		policy       *gotwtr.RetryPolicy
+		statuses     []int
+		call         func(ctx context.Context, c *gotwtr.Client) error
+		wantRequests int32
+		wantErr      bool
+	}{
+		{
+			name:         "get is retried until it succeeds",
+			policy:       &gotwtr.RetryPolicy{BaseDelay: time.Millisecond, Jitter: 0.5},
+			statuses:     []int{http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusOK},
+			call:         followers,
+			wantRequests: 3,
+		},
+		{
+			name:         "gives up after max attempts",
+			policy:       &gotwtr.RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond},
+			statuses:     []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusOK},
+			call:         followers,
+			wantRequests: 2,
+			wantErr:      true,
+		},
+		{
+			name:         "client errors are not retried",
+			policy:       &gotwtr.RetryPolicy{BaseDelay: time.Millisecond},
+			statuses:     []int{http.StatusBadRequest, http.StatusOK},
+			call:         followers,
+			wantRequests: 1,
+			wantErr:      true,
+		},
+		{
+			name:         "safe write is retried with the same body",
+			policy:       &gotwtr.RetryPolicy{BaseDelay: time.Millisecond},
+			statuses:     []int{http.StatusInternalServerError, http.StatusOK},
+			call:         postFollowing,
+			wantRequests: 2,
+		},
+		{
+			name:         "post tweet is not retried by default",
+			policy:       &gotwtr.RetryPolicy{BaseDelay: time.Millisecond},
+			statuses:     []int{http.StatusServiceUnavailable, http.StatusCreated},
+			call:         postTweet,
+			wantRequests: 1,
+			wantErr:      true,
+		},
+		{
+			name:         "post tweet is retried when opted in",
+			policy:       &gotwtr.RetryPolicy{BaseDelay: time.Millisecond, RetryUnsafeWrites: true},
+			statuses:     []int{http.StatusServiceUnavailable, http.StatusCreated},
+			call:         postTweet,
+			wantRequests: 2,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var (
+				requests  int32
+				firstBody atomic.Value
+			)
+			c := gotwtr.New("key",
+				gotwtr.WithRetryPolicy(tt.policy),
+				gotwtr.WithHTTPClient(mockHTTPClient(func(req *http.Request) *http.Response {
+					n := atomic.AddInt32(&requests, 1)
+					if req.Body != nil {
+						b, _ := io.ReadAll(req.Body)
+						if n == 1 {
+							firstBody.Store(string(b))
+						} else if got := firstBody.Load(); got != string(b) {
+							t.Errorf("attempt %d sent body %q, want %q", n, b, got)
+						}
+					}
+					status := tt.statuses[n-1]
+					return &http.Response{
+						StatusCode: status,
+						Status:     http.StatusText(status),
+						Body:       io.NopCloser(strings.NewReader(`{}`)),
+					}
+				})),
+			)
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			err := tt.call(ctx, c)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("unexpected error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := atomic.LoadInt32(&requests); got != tt.wantRequests {
+				t.Errorf("sent %d requests, want %d", got, tt.wantRequests)
+			}
+		})
+	}
+}
+
+func Test_RetryPolicyContext(t *testing.T) {
+	t.Parallel()
+	var requests int32
+	c := gotwtr.New("key",
+		gotwtr.WithRetryPolicy(&gotwtr.RetryPolicy{MaxAttempts: 5, BaseDelay: time.Hour}),
+		gotwtr.WithHTTPClient(mockHTTPClient(func(req *http.Request) *http.Response {
+			atomic.AddInt32(&requests, 1)
+			return &http.Response{
+				StatusCode: http.StatusServiceUnavailable,
+				Body:       io.NopCloser(strings.NewReader(`{}`)),
+			}
+		})),
+	)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := c.Followers(ctx, "2244994945"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("client.Followers() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if got := atomic.LoadInt32(&requests); got != 1 {
+		t.Errorf("client.Followers() sent %d requests, want 1", got)
+	}
+}
@@ -13,7 +13,7 @@ func retweetsLookup(ctx context.Context, c *client, tweetID string, opt ...*Retw
 	if tweetID == "" {
 		return nil, errors.New("retweets lookup: tweetID parameter is required")
 	}
-	ep := fmt.Sprintf(retweetsLookupURL, tweetID)
+	ep := c.baseURL + fmt.Sprintf(retweetsLookupURL, tweetID)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ep, nil)
 	if err != nil {
@@ -32,7 +32,7 @@ func retweetsLookup(ctx context.Context, c *client, tweetID string, opt ...*Retw
 	}
 	ropt.addQuery(req)
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("retweets lookup response: %w", err)
 	}
@@ -43,11 +43,7 @@ func retweetsLookup(ctx context.Context, c *client, tweetID string, opt ...*Retw
 		return nil, fmt.Errorf("retweets lookup decode: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &retweetsLookup, &HTTPError{
-			APIName: "retweets lookup",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &retweetsLookup, newAPIError("retweets lookup", req, resp)
 	}
 
 	return &retweetsLookup, nil
@@ -57,7 +53,7 @@ func postRetweet(ctx context.Context, c *client, userID string, tweetID string)
 	if userID == "" {
 		return nil, errors.New("post retweet: userID parameter is required")
 	}
-	ep := fmt.Sprintf(postRetweetURL, userID)
+	ep := c.baseURL + fmt.Sprintf(postRetweetURL, userID)
 
 	if tweetID == "" {
 		return nil, errors.New("post retweet: tweetID parameter is required")
@@ -77,7 +73,7 @@ func postRetweet(ctx context.Context, c *client, userID string, tweetID string)
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.bearerToken))
 	req.Header.Set("Content-Type", "application/json")
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("post retweet response: %w", err)
 	}
@@ -88,11 +84,7 @@ func postRetweet(ctx context.Context, c *client, userID string, tweetID string)
 		return nil, fmt.Errorf("post retweet decode: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &postRetweet, &HTTPError{
-			APIName: "post retweet",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &postRetweet, newAPIError("post retweet", req, resp)
 	}
 
 	return &postRetweet, nil
@@ -105,7 +97,7 @@ func undoRetweet(ctx context.Context, c *client, userID string, sourceTweetID st
 	if sourceTweetID == "" {
 		return nil, errors.New("undo retweet: sourceTweetID parameter is required")
 	}
-	ep := fmt.Sprintf(undoRetweetURL, userID, sourceTweetID)
+	ep := c.baseURL + fmt.Sprintf(undoRetweetURL, userID, sourceTweetID)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, ep, nil)
 	if err != nil {
@@ -113,7 +105,7 @@ func undoRetweet(ctx context.Context, c *client, userID string, sourceTweetID st
 	}
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.bearerToken))
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("undo retweet response: %w", err)
 	}
@@ -124,11 +116,7 @@ func undoRetweet(ctx context.Context, c *client, userID string, sourceTweetID st
 		return nil, fmt.Errorf("undo retweet decode: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &undoRetweet, &HTTPError{
-			APIName: "undo retweet",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &undoRetweet, newAPIError("undo retweet", req, resp)
 	}
 
 	return &undoRetweet, nil
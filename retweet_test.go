@@ -103,7 +103,7 @@ func Test_retweetsLookup(t *testing.T) {
 				Users: []*gotwtr.User{
 					{
 						ID:            "1065249714214457345",
-						CreatedAt:     "2018-11-21T14:24:58.000Z",
+						CreatedAt:     mustParseTime("2018-11-21T14:24:58.000Z"),
 						Name:          "Spaces",
 						PinnedTweetID: "1389270063807598594",
 						Description:   "Twitter Spaces is where live audio conversations happen.",
@@ -111,21 +111,21 @@ func Test_retweetsLookup(t *testing.T) {
 					},
 					{
 						ID:          "783214",
-						CreatedAt:   "2007-02-20T14:35:54.000Z",
+						CreatedAt:   mustParseTime("2007-02-20T14:35:54.000Z"),
 						Name:        "Twitter",
 						Description: "What's happening?!",
 						UserName:    "Twitter",
 					},
 					{
 						ID:          "1526228120",
-						CreatedAt:   "2013-06-17T23:57:45.000Z",
+						CreatedAt:   mustParseTime("2013-06-17T23:57:45.000Z"),
 						Name:        "Twitter Data",
 						Description: "Data-driven insights about notable moments and conversations from Twitter, Inc., plus tips and tricks to help you get the most out of Twitter data.",
 						UserName:    "TwitterData",
 					},
 					{
 						ID:            "2244994945",
-						CreatedAt:     "2013-12-14T04:35:55.000Z",
+						CreatedAt:     mustParseTime("2013-12-14T04:35:55.000Z"),
 						Name:          "Twitter Dev",
 						PinnedTweetID: "1354143047324299264",
 						Description:   "The voice of the #TwitterDev team and your official source for updates, news, and events, related to the #TwitterAPI.",
@@ -133,7 +133,7 @@ func Test_retweetsLookup(t *testing.T) {
 					},
 					{
 						ID:            "6253282",
-						CreatedAt:     "2007-05-23T06:01:13.000Z",
+						CreatedAt:     mustParseTime("2007-05-23T06:01:13.000Z"),
 						Name:          "Twitter API",
 						PinnedTweetID: "1293595870563381249",
 						Description:   "Tweets about changes and service issues. Follow @TwitterDev for more.",
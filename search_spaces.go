@@ -13,7 +13,7 @@ func searchSpaces(ctx context.Context, c *client, searchTerm string, opt ...*Sea
 		return nil, errors.New("search spaces: searchTerm parameter is required")
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, searchSpacesURL, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+searchSpacesURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("search spaces new request with ctx: %w", err)
 	}
@@ -30,7 +30,7 @@ func searchSpaces(ctx context.Context, c *client, searchTerm string, opt ...*Sea
 	}
 	sopt.addQuery(req, searchTerm)
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("search spaces: %w", err)
 	}
@@ -41,11 +41,7 @@ func searchSpaces(ctx context.Context, c *client, searchTerm string, opt ...*Sea
 		return nil, fmt.Errorf("search spaces: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return nil, &HTTPError{
-			APIName: "search spaces",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return nil, newAPIError("search spaces", req, resp)
 	}
 
 	return &ssr, nil
@@ -16,7 +16,7 @@ func searchRecentTweets(ctx context.Context, c *client, tweet string, opt ...*Se
 		return nil, errors.New("search recent tweets: tweet parameter must be less than or equal to 512 characters")
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, searchRecentTweetsURL, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+searchRecentTweetsURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("search recent tweets new request with ctx: %w", err)
 	}
@@ -44,7 +44,7 @@ func searchRecentTweets(ctx context.Context, c *client, tweet string, opt ...*Se
 	}
 	sopt.addQuery(req, tweet)
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("search recent tweets: %w", err)
 	}
@@ -55,11 +55,7 @@ func searchRecentTweets(ctx context.Context, c *client, tweet string, opt ...*Se
 		return nil, fmt.Errorf("search recent tweets: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &str, &HTTPError{
-			APIName: "search recent tweets",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &str, newAPIError("search recent tweets", req, resp)
 	}
 
 	return &str, nil
@@ -73,7 +69,7 @@ func searchAllTweets(ctx context.Context, c *client, tweet string, opt ...*Searc
 		return nil, errors.New("search all tweets: tweet parameter must be less than or equal to 512 characters")
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, searchAllTweetsURL, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+searchAllTweetsURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("search all tweets new request with ctx: %w", err)
 	}
@@ -101,7 +97,7 @@ func searchAllTweets(ctx context.Context, c *client, tweet string, opt ...*Searc
 	}
 	sopt.addQuery(req, tweet)
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("search all tweets: %w", err)
 	}
@@ -112,11 +108,7 @@ func searchAllTweets(ctx context.Context, c *client, tweet string, opt ...*Searc
 		return nil, fmt.Errorf("search all tweets: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &str, &HTTPError{
-			APIName: "search all tweets",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &str, newAPIError("search all tweets", req, resp)
 	}
 
 	return &str, nil
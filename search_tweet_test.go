@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 
@@ -346,3 +347,35 @@ func Test_searchAllTweets(t *testing.T) {
 		})
 	}
 }
+
+func Test_SearchTweetsOptionSetIDWindow(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2018, 10, 10, 20, 19, 24, 211000000, time.UTC)
+	end := start.Add(time.Second)
+	opt := &gotwtr.SearchTweetsOption{}
+	opt.SetIDWindow(start, end)
+	if opt.SinceID != "1050118621197500415" || opt.UntilID != "1050118625391804416" {
+		t.Fatalf("SearchTweetsOption.SetIDWindow() = %q, %q", opt.SinceID, opt.UntilID)
+	}
+
+	var query string
+	c := gotwtr.New("test-key", gotwtr.WithHTTPClient(mockHTTPClient(func(req *http.Request) *http.Response {
+		query = req.URL.RawQuery
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{"data": []}`)),
+		}
+	})))
+	if _, err := c.SearchRecentTweets(context.Background(), "gopher", opt); err != nil {
+		t.Fatalf("SearchRecentTweets() error = %v", err)
+	}
+	if !strings.Contains(query, "since_id=1050118621197500415") || !strings.Contains(query, "until_id=1050118625391804416") {
+		t.Errorf("SearchRecentTweets() query = %q, want the ID window", query)
+	}
+
+	// a zero time leaves its bound unchanged.
+	opt.SetIDWindow(time.Time{}, end.Add(time.Second))
+	if opt.SinceID != "1050118621197500415" || opt.UntilID == "1050118625391804416" {
+		t.Errorf("SearchTweetsOption.SetIDWindow() = %q, %q, want only the until ID moved", opt.SinceID, opt.UntilID)
+	}
+}
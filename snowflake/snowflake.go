@@ -0,0 +1,107 @@
+// Package snowflake works with the IDs of Tweets, users, Direct Messages and other objects of the Twitter API,
+// which are snowflakes: 64-bit numbers made of a creation time in milliseconds, a worker and a sequence number.
+//
+// The API sends IDs as decimal strings, which this package compares numerically and turns into times and back:
+//
+//	id, err := snowflake.Parse(tweet.ID)
+//	created := id.Time()
+//	since := snowflake.FromTime(time.Now().Add(-time.Hour))
+//
+// FYI https://developer.twitter.com/en/docs/twitter-ids
+package snowflake
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Epoch is the time of the first snowflake, in milliseconds since the Unix epoch.
+const Epoch int64 = 1288834974657
+
+const (
+	sequenceBits   = 12
+	workerBits     = 5
+	datacenterBits = 5
+	timestampShift = sequenceBits + workerBits + datacenterBits
+	timestampMask  = 1<<41 - 1
+)
+
+// ErrInvalidID is returned by Parse when an ID is not a decimal snowflake.
+var ErrInvalidID = errors.New("invalid snowflake id")
+
+// ID is a snowflake.
+type ID uint64
+
+// Parse parses a decimal ID.
+func Parse(s string) (ID, error) {
+	n, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidID, s)
+	}
+	return ID(n), nil
+}
+
+// FromTime returns the smallest ID created at t. IDs created before the snowflake Epoch are 0.
+func FromTime(t time.Time) ID {
+	ms := t.UnixMilli() - Epoch
+	if ms <= 0 {
+		return 0
+	}
+	return ID(ms&timestampMask) << timestampShift
+}
+
+// String returns the decimal ID, as sent by the API.
+func (id ID) String() string {
+	return strconv.FormatUint(uint64(id), 10)
+}
+
+// Time returns the creation time of the ID, in milliseconds.
+func (id ID) Time() time.Time {
+	return time.UnixMilli(int64(id>>timestampShift) + Epoch)
+}
+
+// DatacenterID returns the datacenter which generated the ID.
+func (id ID) DatacenterID() int {
+	return int(id>>(sequenceBits+workerBits)) & (1<<datacenterBits - 1)
+}
+
+// WorkerID returns the worker which generated the ID.
+func (id ID) WorkerID() int {
+	return int(id>>sequenceBits) & (1<<workerBits - 1)
+}
+
+// Sequence returns the sequence number of the ID within its millisecond and worker.
+func (id ID) Sequence() int {
+	return int(id) & (1<<sequenceBits - 1)
+}
+
+// Compare compares two decimal IDs numerically and returns -1, 0 or +1. Unlike Parse it accepts IDs of any length,
+// and an empty ID is smaller than any other, which makes it a natural starting point.
+func Compare(a, b string) int {
+	a = strings.TrimLeft(a, "0")
+	b = strings.TrimLeft(b, "0")
+	switch {
+	case len(a) < len(b):
+		return -1
+	case len(a) > len(b):
+		return 1
+	default:
+		return strings.Compare(a, b)
+	}
+}
+
+// Newer reports whether the ID a is newer, that is greater, than b.
+func Newer(a, b string) bool {
+	return Compare(a, b) > 0
+}
+
+// Sort sorts decimal IDs in increasing numeric order, oldest first.
+func Sort(ids []string) {
+	sort.Slice(ids, func(i, j int) bool {
+		return Compare(ids[i], ids[j]) < 0
+	})
+}
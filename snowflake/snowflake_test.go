@@ -0,0 +1,122 @@
+package snowflake_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sivchari/gotwtr/snowflake"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name           string
+		id             string
+		wantTime       time.Time
+		wantDatacenter int
+		wantWorker     int
+		wantSequence   int
+		wantErr        error
+	}{
+		{
+			name:           "tweet",
+			id:             "1050118621198921728",
+			wantTime:       time.Date(2018, 10, 10, 20, 19, 24, 211000000, time.UTC),
+			wantDatacenter: 10,
+			wantWorker:     27,
+		},
+		{
+			name:           "sequence",
+			id:             "1445880548472328197",
+			wantTime:       time.Date(2021, 10, 6, 22, 36, 0, 574000000, time.UTC),
+			wantDatacenter: 11,
+			wantWorker:     17,
+			wantSequence:   5,
+		},
+		{
+			name:    "not a number",
+			id:      "TwitterDev",
+			wantErr: snowflake.ErrInvalidID,
+		},
+		{
+			name:    "overflow",
+			id:      "18446744073709551616",
+			wantErr: snowflake.ErrInvalidID,
+		},
+	}
+	for i, tt := range tests {
+		i := i
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			id, err := snowflake.Parse(tt.id)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Parse() index = %v error = %v, want %v", i, err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if !id.Time().Equal(tt.wantTime) {
+				t.Errorf("ID.Time() index = %v = %v, want %v", i, id.Time().UTC(), tt.wantTime)
+			}
+			if id.DatacenterID() != tt.wantDatacenter || id.WorkerID() != tt.wantWorker || id.Sequence() != tt.wantSequence {
+				t.Errorf("ID index = %v datacenter, worker, sequence = %d, %d, %d, want %d, %d, %d", i,
+					id.DatacenterID(), id.WorkerID(), id.Sequence(), tt.wantDatacenter, tt.wantWorker, tt.wantSequence)
+			}
+			if id.String() != tt.id {
+				t.Errorf("ID.String() index = %v = %v, want %v", i, id.String(), tt.id)
+			}
+		})
+	}
+}
+
+func TestFromTime(t *testing.T) {
+	t.Parallel()
+	created := time.Date(2018, 10, 10, 20, 19, 24, 211000000, time.UTC)
+	id := snowflake.FromTime(created)
+	if !id.Time().Equal(created) || id.Sequence() != 0 || id.WorkerID() != 0 || id.DatacenterID() != 0 {
+		t.Errorf("FromTime() = %v, want the first ID of %v", id, created)
+	}
+	tweet, _ := snowflake.Parse("1050118621198921728")
+	if id > tweet || snowflake.FromTime(created.Add(time.Millisecond)) <= tweet {
+		t.Errorf("FromTime() = %v, want it to bound %v", id, tweet)
+	}
+	if got := snowflake.FromTime(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)); got != 0 {
+		t.Errorf("FromTime() before the epoch = %v, want 0", got)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{a: "1050118621198921728", b: "1050118621198921728", want: 0},
+		{a: "999", b: "1000", want: -1},
+		{a: "1212092628029698048", b: "1050118621198921728", want: 1},
+		{a: "", b: "1", want: -1},
+		{a: "0012", b: "12", want: 0},
+		{a: "100000000000000000000", b: "18446744073709551615", want: 1},
+	}
+	for i, tt := range tests {
+		if got := snowflake.Compare(tt.a, tt.b); got != tt.want {
+			t.Errorf("Compare() index = %v (%q, %q) = %d, want %d", i, tt.a, tt.b, got, tt.want)
+		}
+		if got := snowflake.Newer(tt.a, tt.b); got != (tt.want > 0) {
+			t.Errorf("Newer() index = %v (%q, %q) = %v, want %v", i, tt.a, tt.b, got, tt.want > 0)
+		}
+	}
+}
+
+func TestSort(t *testing.T) {
+	t.Parallel()
+	ids := []string{"1212092628029698048", "999", "1050118621198921728", "1000"}
+	snowflake.Sort(ids)
+	if diff := cmp.Diff([]string{"999", "1000", "1050118621198921728", "1212092628029698048"}, ids); diff != "" {
+		t.Errorf("Sort() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -40,19 +40,19 @@ func spaceFieldsToString(sfs []SpaceField) []string {
 type Space struct {
 	ID               string   `json:"id"`
 	State            string   `json:"state"`
-	CreatedAt        string   `json:"created_at,omitempty"`
-	EndedAt          string   `json:"ended_at,omitempty"`
+	CreatedAt        Time     `json:"created_at,omitempty"`
+	EndedAt          Time     `json:"ended_at,omitempty"`
 	HostIDs          []string `json:"host_ids,omitempty"`
 	Lang             string   `json:"lang,omitempty"`
 	IsTicketed       bool     `json:"is_ticketed,omitempty"`
 	InvitedUserIDs   []string `json:"invited_user_ids,omitempty"`
 	ParticipantCount int      `json:"participant_count,omitempty"`
-	ScheduledStart   string   `json:"scheduled_start,omitempty"`
+	ScheduledStart   Time     `json:"scheduled_start,omitempty"`
 	SpeakerIDs       []string `json:"speaker_ids,omitempty"`
-	StartedAt        string   `json:"started_at,omitempty"`
+	StartedAt        Time     `json:"started_at,omitempty"`
 	TopicIDs         []string `json:"topic_ids,omitempty"`
 	Title            string   `json:"title,omitempty"`
-	UpdatedAt        string   `json:"updated_at,omitempty"`
+	UpdatedAt        Time     `json:"updated_at,omitempty"`
 	CreatorID        string   `json:"creator_id,omitempty"`
 }
 
@@ -102,11 +102,18 @@ type DiscoverSpacesMeta struct {
 }
 
 type UsersPurchasedSpaceTicketResponse struct {
-	Users    []*User                                     `json:"data"`
-	Includes *LookUpUsersWhoPurchasedSpaceTicketIncludes `json:"includes,omitempty"`
-	Errors   []*APIResponseError                         `json:"errors,omitempty"`
+	Users    []*User             `json:"data"`
+	Includes *UserIncludes       `json:"includes,omitempty"`
+	Errors   []*APIResponseError `json:"errors,omitempty"`
 }
 
-type LookUpUsersWhoPurchasedSpaceTicketIncludes struct {
-	Tweets []*Tweet
+type SpaceTweetsResponse struct {
+	Tweets   []*Tweet            `json:"data"`
+	Includes *TweetIncludes      `json:"includes,omitempty"`
+	Errors   []*APIResponseError `json:"errors,omitempty"`
+	Meta     *SpaceTweetsMeta    `json:"meta"`
+}
+
+type SpaceTweetsMeta struct {
+	ResultCount int `json:"result_count"`
 }
@@ -12,7 +12,7 @@ func lookUpSpace(ctx context.Context, c *client, spaceID string, opt ...*SpaceOp
 	if spaceID == "" {
 		return nil, errors.New("look up space: spaceID parameter is required")
 	}
-	ep := fmt.Sprintf(spaceURL, spaceID)
+	ep := c.baseURL + fmt.Sprintf(spaceURL, spaceID)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ep, nil)
 	if err != nil {
@@ -32,7 +32,7 @@ func lookUpSpace(ctx context.Context, c *client, spaceID string, opt ...*SpaceOp
 	}
 	sopt.addQuery(req)
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("look up space response: %w", err)
 	}
@@ -45,11 +45,7 @@ func lookUpSpace(ctx context.Context, c *client, spaceID string, opt ...*SpaceOp
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return &sr, &HTTPError{
-			APIName: "space lookup by id",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &sr, newAPIError("space lookup by id", req, resp)
 	}
 
 	return &sr, nil
@@ -63,7 +59,7 @@ func lookUpSpaces(ctx context.Context, c *client, spaceIDs []string, opt ...*Spa
 		return nil, fmt.Errorf("look up spaces: spaceIDs parameter must be less than %d", spaceLookUpMaxIDs)
 	default:
 	}
-	ep := spacesURL
+	ep := c.baseURL + spacesURL
 	for i, sid := range spaceIDs {
 		if i+1 < len(spaceIDs) {
 			ep += fmt.Sprintf("%s,", sid)
@@ -89,7 +85,7 @@ func lookUpSpaces(ctx context.Context, c *client, spaceIDs []string, opt ...*Spa
 	}
 	sopt.addQuery(req)
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("look up spaces response: %w", err)
 	}
@@ -102,11 +98,7 @@ func lookUpSpaces(ctx context.Context, c *client, spaceIDs []string, opt ...*Spa
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return &sr, &HTTPError{
-			APIName: "look up spaces",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &sr, newAPIError("look up spaces", req, resp)
 	}
 
 	return &sr, nil
@@ -116,7 +108,7 @@ func usersPurchasedSpaceTicket(ctx context.Context, c *client, spaceID string, o
 	if spaceID == "" {
 		return nil, errors.New("users purchased space ticket: spaceID parameter is required")
 	}
-	ep := fmt.Sprintf(usersPurchasedSpaceTicketURL, spaceID)
+	ep := c.baseURL + fmt.Sprintf(usersPurchasedSpaceTicketURL, spaceID)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ep, nil)
 	if err != nil {
@@ -136,7 +128,7 @@ func usersPurchasedSpaceTicket(ctx context.Context, c *client, spaceID string, o
 	}
 	uopt.addQuery(req)
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("users purchased space ticket response: %w", err)
 	}
@@ -149,12 +141,51 @@ func usersPurchasedSpaceTicket(ctx context.Context, c *client, spaceID string, o
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return &upstr, &HTTPError{
-			APIName: "users purchased space ticket",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &upstr, newAPIError("users purchased space ticket", req, resp)
 	}
 
 	return &upstr, nil
 }
+
+func spaceTweets(ctx context.Context, c *client, spaceID string, opt ...*SpaceTweetsOption) (*SpaceTweetsResponse, error) {
+	if spaceID == "" {
+		return nil, errors.New("space tweets: spaceID parameter is required")
+	}
+	ep := c.baseURL + fmt.Sprintf(spaceTweetsURL, spaceID)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ep, nil)
+	if err != nil {
+		return nil, fmt.Errorf("space tweets new request with ctx: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.bearerToken))
+
+	var sopt SpaceTweetsOption
+	switch len(opt) {
+	case 0:
+		// do nothing
+	case 1:
+		sopt = *opt[0]
+	default:
+		return nil, errors.New("space tweets: only one option is allowed")
+	}
+	sopt.addQuery(req)
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("space tweets response: %w", err)
+	}
+
+	defer resp.Body.Close()
+
+	var st SpaceTweetsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&st); err != nil {
+		return nil, fmt.Errorf("space tweets: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return &st, newAPIError("space tweets", req, resp)
+	}
+
+	return &st, nil
+}
@@ -373,14 +373,14 @@ func Test_usersPurchasedSpaceTicket(t *testing.T) {
 						ID:            "2244994945",
 						UserName:      "TwitterProd",
 						Name:          "Twitter Prod",
-						CreatedAt:     "2013-12-14T04:35:55.000Z",
+						CreatedAt:     mustParseTime("2013-12-14T04:35:55.000Z"),
 						PinnedTweetID: "1255542774432063488",
 					},
 					{
 						ID:            "783214",
 						UserName:      "Twitter",
 						Name:          "Twitter",
-						CreatedAt:     "2007-02-20T14:35:54.000Z",
+						CreatedAt:     mustParseTime("2007-02-20T14:35:54.000Z"),
 						PinnedTweetID: "1274087687469715457",
 					},
 				},
@@ -388,12 +388,12 @@ func Test_usersPurchasedSpaceTicket(t *testing.T) {
 					Tweets: []*gotwtr.Tweet{
 						{
 							ID:        "1255542774432063488",
-							CreatedAt: "2020-04-29T17:01:38.000Z",
+							CreatedAt: mustParseTime("2020-04-29T17:01:38.000Z"),
 							Text:      "During these unprecedented times, what’s happening on Twitter can help the world better understand &amp; respond to the pandemic. nnWe're launching a free COVID-19 stream endpoint so qualified devs &amp; researchers can study the public conversation in real-time. https://t.co/BPqMcQzhId",
 						},
 						{
 							ID:        "1274087687469715457",
-							CreatedAt: "2020-06-19T21:12:30.000Z",
+							CreatedAt: mustParseTime("2020-06-19T21:12:30.000Z"),
 							Text:      "📍 Minneapolisn🗣️ @FredTJoseph https://t.co/lNTOkyguG1",
 						},
 					},
@@ -419,3 +419,143 @@ func Test_usersPurchasedSpaceTicket(t *testing.T) {
 		})
 	}
 }
+
+func Test_spaceTweets(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		ctx    context.Context
+		client *http.Client
+		id     string
+		opt    []*gotwtr.SpaceTweetsOption
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *gotwtr.SpaceTweetsResponse
+		wantErr bool
+	}{
+		{
+			name: "200 ok tweets shared in a Space",
+			args: args{
+				ctx: context.Background(),
+				client: mockHTTPClient(func(request *http.Request) *http.Response {
+					if request.URL.Path != "/2/spaces/1DXxyRYNejbKM/tweets" {
+						t.Errorf("the path is not correct got %s", request.URL.Path)
+					}
+					if got := request.URL.Query().Get("expansions"); got != "author_id" {
+						t.Errorf("the expansions are not correct got %s", got)
+					}
+					data := `{
+						"data": [
+							{
+								"id": "1389270063807598594",
+								"text": "now, everyone with 600 or more followers can host a Space.",
+								"author_id": "1065249714214457345"
+							}
+						],
+						"includes": {
+							"users": [
+								{
+									"id": "1065249714214457345",
+									"name": "Spaces",
+									"username": "TwitterSpaces"
+								}
+							]
+						},
+						"meta": {
+							"result_count": 1
+						}
+					}`
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       io.NopCloser(strings.NewReader(data)),
+					}
+				}),
+				id: "1DXxyRYNejbKM",
+				opt: []*gotwtr.SpaceTweetsOption{
+					{
+						Expansions: []gotwtr.Expansion{gotwtr.ExpansionAuthorID},
+					},
+				},
+			},
+			want: &gotwtr.SpaceTweetsResponse{
+				Tweets: []*gotwtr.Tweet{
+					{
+						ID:       "1389270063807598594",
+						Text:     "now, everyone with 600 or more followers can host a Space.",
+						AuthorID: "1065249714214457345",
+					},
+				},
+				Includes: &gotwtr.TweetIncludes{
+					Users: []*gotwtr.User{
+						{
+							ID:       "1065249714214457345",
+							Name:     "Spaces",
+							UserName: "TwitterSpaces",
+						},
+					},
+				},
+				Meta: &gotwtr.SpaceTweetsMeta{
+					ResultCount: 1,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "404 not found",
+			args: args{
+				ctx: context.Background(),
+				client: mockHTTPClient(func(request *http.Request) *http.Response {
+					data := `{
+						"errors": [
+							{
+								"value": "1DXxyRYNejbKX",
+								"detail": "Could not find space with id: [1DXxyRYNejbKX].",
+								"title": "Not Found Error",
+								"resource_type": "space",
+								"parameter": "id",
+								"resource_id": "1DXxyRYNejbKX",
+								"type": "https://api.twitter.com/2/problems/resource-not-found"
+							}
+						]
+					}`
+					return &http.Response{
+						StatusCode: http.StatusNotFound,
+						Body:       io.NopCloser(strings.NewReader(data)),
+					}
+				}),
+				id: "1DXxyRYNejbKX",
+			},
+			want: &gotwtr.SpaceTweetsResponse{
+				Errors: []*gotwtr.APIResponseError{
+					{
+						Value:        "1DXxyRYNejbKX",
+						Detail:       "Could not find space with id: [1DXxyRYNejbKX].",
+						Title:        "Not Found Error",
+						ResourceType: "space",
+						Parameter:    "id",
+						ResourceID:   "1DXxyRYNejbKX",
+						Type:         "https://api.twitter.com/2/problems/resource-not-found",
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c := gotwtr.New("key", gotwtr.WithHTTPClient(tt.args.client))
+			got, err := c.SpaceTweets(tt.args.ctx, tt.args.id, tt.args.opt...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("client.SpaceTweets() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("client.SpaceTweets() mismatch (-want +got):\n%s", diff)
+				return
+			}
+		})
+	}
+}
@@ -137,3 +137,37 @@ func (u *UsersPurchasedSpaceTicketOption) addQuery(req *http.Request) {
 		req.URL.RawQuery = q.Encode()
 	}
 }
+
+type SpaceTweetsOption struct {
+	Expansions  []Expansion
+	MediaFields []MediaField
+	PlaceFields []PlaceField
+	PollFields  []PollField
+	TweetFields []TweetField
+	UserFields  []UserField
+}
+
+func (st *SpaceTweetsOption) addQuery(req *http.Request) {
+	q := req.URL.Query()
+	if len(st.Expansions) > 0 {
+		q.Add("expansions", strings.Join(expansionsToString(st.Expansions), ","))
+	}
+	if len(st.MediaFields) > 0 {
+		q.Add("media.fields", strings.Join(mediaFieldsToString(st.MediaFields), ","))
+	}
+	if len(st.PlaceFields) > 0 {
+		q.Add("place.fields", strings.Join(placeFieldsToString(st.PlaceFields), ","))
+	}
+	if len(st.PollFields) > 0 {
+		q.Add("poll.fields", strings.Join(pollFieldsToString(st.PollFields), ","))
+	}
+	if len(st.TweetFields) > 0 {
+		q.Add("tweet.fields", strings.Join(tweetFieldsToString(st.TweetFields), ","))
+	}
+	if len(st.UserFields) > 0 {
+		q.Add("user.fields", strings.Join(userFieldsToString(st.UserFields), ","))
+	}
+	if len(q) > 0 {
+		req.URL.RawQuery = q.Encode()
+	}
+}
@@ -0,0 +1,296 @@
+package gotwtr
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	defaultStreamStallTimeout = 30 * time.Second
+	// Backoff tiers recommended by Twitter for reconnecting to a stream.
+	streamNetworkBackoffStep  = 250 * time.Millisecond
+	streamNetworkBackoffMax   = 16 * time.Second
+	streamHTTPBackoffMin      = 5 * time.Second
+	streamHTTPBackoffMax      = 320 * time.Second
+	streamRateLimitBackoffMin = time.Minute
+	streamRateLimitBackoffMax = 16 * time.Minute
+)
+
+// ErrStreamStalled is sent when a stream received neither data nor a keep-alive signal within its stall timeout.
+var ErrStreamStalled = errors.New("stream stalled: no data or keep-alive received")
+
+// streamMessage is a message of a streaming endpoint.
+type streamMessage interface {
+	// disconnectErrors returns the errors of a message which only reports the reason of an operational disconnect.
+	disconnectErrors() []*APIResponseError
+}
+
+// streamSettings are the connection settings shared by the options of every stream.
+type streamSettings struct {
+	backfillMinutes  int
+	disableReconnect bool
+	stallTimeout     time.Duration
+	onStateChange    func(state StreamState, err error)
+}
+
+// stream is the engine behind every streaming endpoint. It decodes the messages of the connection into ch,
+// sends errors to errCh and reconnects with backoff until it is stopped.
+type stream[T streamMessage] struct {
+	client   *client
+	apiName  string
+	ctx      context.Context
+	errCh    chan<- error
+	ch       chan<- T
+	settings streamSettings
+	cancel   context.CancelFunc
+	done     chan struct{}
+	stopOnce sync.Once
+	wg       sync.WaitGroup
+}
+
+// newStream connects to the streaming endpoint path in the background. optErr reports an invalid option,
+// which is sent to errCh instead of connecting.
+func newStream[T streamMessage](ctx context.Context, c *client, apiName, path string, ch chan<- T, errCh chan<- error, settings streamSettings, addQuery func(req *http.Request), optErr error) *stream[T] {
+	ctx, cancel := context.WithCancel(ctx)
+	s := &stream[T]{
+		client:   c,
+		apiName:  apiName,
+		ctx:      ctx,
+		errCh:    errCh,
+		ch:       ch,
+		settings: settings,
+		cancel:   cancel,
+		done:     make(chan struct{}),
+	}
+	s.wg.Add(1)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+path, nil)
+	if err != nil {
+		optErr = fmt.Errorf("%s new request with ctx: %w", apiName, err)
+	}
+	if optErr != nil {
+		go s.fail(optErr)
+		return s
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.bearerToken))
+	addQuery(req)
+	go s.retry(req)
+	return s
+}
+
+// Stop closes the stream and waits for its goroutine to end. It is safe to call more than once.
+func (s *stream[T]) Stop() {
+	s.stopOnce.Do(func() {
+		s.cancel()
+		close(s.done)
+	})
+	s.wg.Wait()
+}
+
+func stopped(done <-chan struct{}) bool {
+	select {
+	case <-done:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s *stream[T]) setState(state StreamState, err error) {
+	if s.settings.onStateChange != nil {
+		s.settings.onStateChange(state, err)
+	}
+}
+
+func (s *stream[T]) sendErr(err error) {
+	select {
+	case s.errCh <- err:
+	case <-s.done:
+	case <-s.ctx.Done():
+	}
+}
+
+func (s *stream[T]) fail(err error) {
+	defer s.wg.Done()
+	s.sendErr(err)
+	s.setState(StreamStopped, err)
+}
+
+// retry keeps the stream connected until it is stopped, reconnecting with backoff after every disconnection.
+func (s *stream[T]) retry(req *http.Request) {
+	defer s.wg.Done()
+	var b streamBackoff
+	for attempt := 0; ; attempt++ {
+		if attempt == 1 && s.settings.backfillMinutes > 0 {
+			q := req.URL.Query()
+			q.Set("backfill_minutes", strconv.Itoa(s.settings.backfillMinutes))
+			req.URL.RawQuery = q.Encode()
+		}
+		s.setState(StreamConnecting, nil)
+		connected, err := s.connect(req)
+		if connected {
+			b.reset()
+		}
+		if stopped(s.done) || s.ctx.Err() != nil {
+			s.setState(StreamStopped, nil)
+			return
+		}
+		s.sendErr(err)
+		if s.settings.disableReconnect || permanentStreamError(err) {
+			s.setState(StreamStopped, err)
+			return
+		}
+		s.setState(StreamDisconnected, err)
+		if err := sleepUntil(req.Context(), time.Now().Add(b.next(err))); err != nil {
+			s.setState(StreamStopped, nil)
+			return
+		}
+	}
+}
+
+// connect streams messages until the connection is lost. It reports whether the connection was accepted and why it ended.
+func (s *stream[T]) connect(req *http.Request) (bool, error) {
+	resp, err := s.client.do(req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return false, newAPIError(s.apiName, req, resp)
+	}
+	s.setState(StreamConnected, nil)
+
+	timeout := s.settings.stallTimeout
+	if timeout == 0 {
+		timeout = defaultStreamStallTimeout
+	}
+	body := newStallReader(resp.Body, timeout)
+	defer body.stop()
+	dec := json.NewDecoder(body)
+	for {
+		var msg T
+		if err := dec.Decode(&msg); err != nil {
+			switch {
+			case body.stalled():
+				return true, ErrStreamStalled
+			case err == io.EOF:
+				return true, err
+			default:
+				return true, fmt.Errorf("%s decode: %w", s.apiName, err)
+			}
+		}
+		if errs := msg.disconnectErrors(); len(errs) > 0 {
+			// Twitter sends the reason of an operational disconnect before closing the connection.
+			return true, &APIError{
+				APIName:    s.apiName,
+				Status:     resp.Status,
+				StatusCode: resp.StatusCode,
+				URL:        req.URL.String(),
+				Type:       errs[0].Type,
+				Title:      errs[0].Title,
+				Detail:     errs[0].Detail,
+				Errors:     errs,
+			}
+		}
+		select {
+		case s.ch <- msg:
+		case <-s.done:
+			return true, nil
+		case <-s.ctx.Done():
+			return true, nil
+		}
+	}
+}
+
+// permanentStreamError reports whether reconnecting after err is pointless, e.g. because the credentials were rejected.
+func permanentStreamError(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.StatusCode >= http.StatusBadRequest && apiErr.StatusCode < http.StatusInternalServerError &&
+		apiErr.StatusCode != http.StatusTooManyRequests
+}
+
+// streamBackoff computes the delay before reconnecting: linear for network errors,
+// exponential for HTTP errors and exponential from a minute for rate limits.
+type streamBackoff struct {
+	network   time.Duration
+	http      time.Duration
+	rateLimit time.Duration
+}
+
+func (b *streamBackoff) reset() {
+	*b = streamBackoff{}
+}
+
+func (b *streamBackoff) next(err error) time.Duration {
+	var apiErr *APIError
+	switch {
+	case errors.Is(err, ErrRateLimited):
+		b.rateLimit = nextBackoff(b.rateLimit, streamRateLimitBackoffMin, streamRateLimitBackoffMax)
+		return b.rateLimit
+	case errors.As(err, &apiErr):
+		b.http = nextBackoff(b.http, streamHTTPBackoffMin, streamHTTPBackoffMax)
+		return b.http
+	default:
+		b.network += streamNetworkBackoffStep
+		if b.network > streamNetworkBackoffMax {
+			b.network = streamNetworkBackoffMax
+		}
+		return b.network
+	}
+}
+
+func nextBackoff(d, min, max time.Duration) time.Duration {
+	if d == 0 {
+		return min
+	}
+	if d *= 2; d > max {
+		return max
+	}
+	return d
+}
+
+// stallReader closes the underlying body when nothing was read from it for timeout.
+type stallReader struct {
+	body      io.ReadCloser
+	timeout   time.Duration
+	timer     *time.Timer
+	isStalled int32
+}
+
+func newStallReader(body io.ReadCloser, timeout time.Duration) *stallReader {
+	r := &stallReader{
+		body:    body,
+		timeout: timeout,
+	}
+	r.timer = time.AfterFunc(timeout, func() {
+		atomic.StoreInt32(&r.isStalled, 1)
+		body.Close()
+	})
+	return r
+}
+
+func (r *stallReader) Read(p []byte) (int, error) {
+	n, err := r.body.Read(p)
+	if n > 0 {
+		r.timer.Reset(r.timeout)
+	}
+	return n, err
+}
+
+func (r *stallReader) stalled() bool {
+	return atomic.LoadInt32(&r.isStalled) == 1
+}
+
+func (r *stallReader) stop() {
+	r.timer.Stop()
+}
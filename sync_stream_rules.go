@@ -0,0 +1,123 @@
+package gotwtr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+type streamRuleKey struct {
+	value string
+	tag   string
+}
+
+func syncStreamRules(ctx context.Context, c *client, desired []*AddRule, opt ...*SyncStreamRulesOption) (*SyncStreamRulesReport, error) {
+	var sopt SyncStreamRulesOption
+	switch len(opt) {
+	case 0:
+		// do nothing
+	case 1:
+		sopt = *opt[0]
+	default:
+		return nil, errors.New("sync stream rules: only one option is allowed")
+	}
+
+	want := make(map[streamRuleKey]bool, len(desired))
+	tags := make(map[string]string, len(desired))
+	var unique []*AddRule
+	for _, rule := range desired {
+		if rule == nil || rule.Value == "" {
+			return nil, errors.New("sync stream rules: value is required for every rule")
+		}
+		if filteredStreamRuleMaxLength < len(rule.Value) {
+			return nil, fmt.Errorf("sync stream rules: value must be at most %d characters: %q", filteredStreamRuleMaxLength, rule.Value)
+		}
+		k := streamRuleKey{value: rule.Value, tag: rule.Tag}
+		if want[k] {
+			continue
+		}
+		// Twitter allows a single rule per value, so the second tag could never be added.
+		if tag, ok := tags[rule.Value]; ok {
+			return nil, fmt.Errorf("sync stream rules: value %q is given with both tag %q and tag %q", rule.Value, tag, rule.Tag)
+		}
+		want[k] = true
+		tags[rule.Value] = rule.Tag
+		unique = append(unique, rule)
+	}
+
+	current, err := retrieveStreamRules(ctx, c)
+	if err != nil {
+		return nil, fmt.Errorf("sync stream rules: %w", err)
+	}
+
+	report := &SyncStreamRulesReport{DryRun: sopt.DryRun}
+	kept := make(map[streamRuleKey]bool, len(current.Rules))
+	var stale []*FilteredRule
+	for _, rule := range current.Rules {
+		k := streamRuleKey{value: rule.Value, tag: rule.Tag}
+		if want[k] && !kept[k] {
+			kept[k] = true
+			report.Unchanged = append(report.Unchanged, rule)
+			continue
+		}
+		stale = append(stale, rule)
+	}
+	var add []*AddRule
+	for _, rule := range unique {
+		if !kept[streamRuleKey{value: rule.Value, tag: rule.Tag}] {
+			add = append(add, rule)
+		}
+	}
+
+	aopt := &AddOrDeleteRulesOption{DryRun: sopt.DryRun}
+	// rules are deleted first, because a rule whose tag changed can not be added while its value is still in use.
+	if len(stale) > 0 {
+		ids := make([]string, 0, len(stale))
+		for _, rule := range stale {
+			ids = append(ids, rule.ID)
+		}
+		resp, err := addOrDeleteRules(ctx, c, &AddOrDeleteJSONBody{Delete: &DeleteRule{IDs: ids}}, aopt)
+		if err != nil {
+			return report, fmt.Errorf("sync stream rules: %w", err)
+		}
+		report.Deleted = stale
+		report.Errors = append(report.Errors, resp.Errors...)
+	}
+	// a dry run does not apply the deletion, so a value which is deleted and added again with another tag
+	// would be reported as a duplicate: it is left out of the add call and reported as a planned addition.
+	retagged := make(map[string]bool)
+	if sopt.DryRun {
+		for _, rule := range stale {
+			retagged[rule.Value] = true
+		}
+	}
+	send := make([]*AddRule, 0, len(add))
+	for _, rule := range add {
+		if !retagged[rule.Value] {
+			send = append(send, rule)
+		}
+	}
+	added := make(map[string]*FilteredRule, len(add))
+	if len(send) > 0 {
+		resp, err := addOrDeleteRules(ctx, c, &AddOrDeleteJSONBody{Add: send}, aopt)
+		if err != nil {
+			return report, fmt.Errorf("sync stream rules: %w", err)
+		}
+		for _, rule := range resp.Rules {
+			added[rule.Value] = rule
+		}
+		report.Errors = append(report.Errors, resp.Errors...)
+	}
+	for _, rule := range add {
+		switch {
+		case retagged[rule.Value]:
+			report.Added = append(report.Added, &FilteredRule{Value: rule.Value, Tag: rule.Tag})
+		case added[rule.Value] != nil:
+			report.Added = append(report.Added, added[rule.Value])
+		}
+	}
+	if len(report.Errors) > 0 {
+		return report, fmt.Errorf("sync stream rules: %d rules were rejected, see the errors of the report", len(report.Errors))
+	}
+	return report, nil
+}
@@ -0,0 +1,114 @@
+package gotwtr_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sivchari/gotwtr"
+	"github.com/sivchari/gotwtr/gotwtrtest"
+)
+
+func ruleKeys(rules []*gotwtr.FilteredRule) []string {
+	keys := make([]string, 0, len(rules))
+	for _, r := range rules {
+		keys = append(keys, r.Value+"#"+r.Tag)
+	}
+	return keys
+}
+
+func Test_SyncStreamRules(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	current := []*gotwtr.AddRule{
+		{Value: "cat has:images", Tag: "cats"},
+		{Value: "dog has:images", Tag: "dogs"},
+		{Value: "from:TwitterDev"},
+	}
+	desired := []*gotwtr.AddRule{
+		{Value: "cat has:images", Tag: "cats"},
+		{Value: "dog has:images", Tag: "puppies"},
+		{Value: "from:TwitterAPI"},
+		{Value: "from:TwitterAPI"},
+	}
+	tests := []struct {
+		name          string
+		opt           []*gotwtr.SyncStreamRulesOption
+		wantAdded     []string
+		wantDeleted   []string
+		wantUnchanged []string
+		wantRules     []string
+		wantErr       bool
+	}{
+		{
+			name:          "apply",
+			wantAdded:     []string{"dog has:images#puppies", "from:TwitterAPI#"},
+			wantDeleted:   []string{"dog has:images#dogs", "from:TwitterDev#"},
+			wantUnchanged: []string{"cat has:images#cats"},
+			wantRules:     []string{"cat has:images#cats", "dog has:images#puppies", "from:TwitterAPI#"},
+		},
+		{
+			name:          "dry run",
+			opt:           []*gotwtr.SyncStreamRulesOption{{DryRun: true}},
+			wantAdded:     []string{"dog has:images#puppies", "from:TwitterAPI#"},
+			wantDeleted:   []string{"dog has:images#dogs", "from:TwitterDev#"},
+			wantUnchanged: []string{"cat has:images#cats"},
+			wantRules:     []string{"cat has:images#cats", "dog has:images#dogs", "from:TwitterDev#"},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			srv := gotwtrtest.NewServer()
+			defer srv.Close()
+			c := srv.Client()
+			if _, err := c.AddOrDeleteRules(ctx, &gotwtr.AddOrDeleteJSONBody{Add: current}); err != nil {
+				t.Fatalf("client.AddOrDeleteRules() error = %v", err)
+			}
+			report, err := c.SyncStreamRules(ctx, desired, tt.opt...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("client.SyncStreamRules() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.wantAdded, ruleKeys(report.Added)); diff != "" {
+				t.Errorf("report.Added mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantDeleted, ruleKeys(report.Deleted)); diff != "" {
+				t.Errorf("report.Deleted mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantUnchanged, ruleKeys(report.Unchanged)); diff != "" {
+				t.Errorf("report.Unchanged mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantRules, ruleKeys(srv.Rules())); diff != "" {
+				t.Errorf("rules mismatch (-want +got):\n%s", diff)
+			}
+			if tt.opt != nil {
+				return
+			}
+			report, err = c.SyncStreamRules(ctx, desired)
+			if err != nil {
+				t.Fatalf("client.SyncStreamRules() second run error = %v", err)
+			}
+			if len(report.Added) != 0 || len(report.Deleted) != 0 {
+				t.Errorf("client.SyncStreamRules() second run changed rules: added %v, deleted %v", ruleKeys(report.Added), ruleKeys(report.Deleted))
+			}
+		})
+	}
+}
+
+func Test_SyncStreamRulesConflictingTags(t *testing.T) {
+	t.Parallel()
+	srv := gotwtrtest.NewServer()
+	defer srv.Close()
+	desired := []*gotwtr.AddRule{
+		{Value: "cat has:images", Tag: "cats"},
+		{Value: "cat has:images", Tag: "kittens"},
+	}
+	if _, err := srv.Client().SyncStreamRules(context.Background(), desired); err == nil {
+		t.Fatal("client.SyncStreamRules() error = nil, want an error for a value given with two tags")
+	}
+	if len(srv.Rules()) != 0 {
+		t.Errorf("rules = %v, want none", ruleKeys(srv.Rules()))
+	}
+}
@@ -0,0 +1,77 @@
+package gotwtr
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// TimeFormat is the format of the timestamps sent by the API.
+const TimeFormat = "2006-01-02T15:04:05.000Z07:00"
+
+// Time is a timestamp of the API, e.g. the creation time of a Tweet. It keeps the text it was decoded from,
+// so that it is encoded back unchanged, and is null in JSON when it is zero.
+type Time struct {
+	time.Time
+	raw string
+}
+
+// NewTime returns t as a Time, which is encoded in TimeFormat in UTC.
+func NewTime(t time.Time) Time {
+	return Time{Time: t}
+}
+
+// ParseTime parses a timestamp in RFC 3339 format, which TimeFormat is a form of.
+func ParseTime(s string) (Time, error) {
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return Time{}, fmt.Errorf("parse time: %w", err)
+	}
+	return Time{Time: t, raw: s}, nil
+}
+
+// String returns the text the time was decoded from, or the time in TimeFormat in UTC.
+// It returns an empty string for the zero Time.
+func (t Time) String() string {
+	switch {
+	case t.raw != "":
+		return t.raw
+	case t.IsZero():
+		return ""
+	default:
+		return t.UTC().Format(TimeFormat)
+	}
+}
+
+// Equal reports whether t and u represent the same time instant, whatever their text.
+func (t Time) Equal(u Time) bool {
+	return t.Time.Equal(u.Time)
+}
+
+func (t Time) MarshalJSON() ([]byte, error) {
+	if t.raw == "" && t.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t.String())
+}
+
+func (t *Time) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		*t = Time{}
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	if s == "" {
+		*t = Time{}
+		return nil
+	}
+	parsed, err := ParseTime(s)
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
@@ -0,0 +1,82 @@
+package gotwtr_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sivchari/gotwtr"
+)
+
+func mustParseTime(s string) gotwtr.Time {
+	t, err := gotwtr.ParseTime(s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func Test_TimeJSON(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		json string
+		want time.Time
+	}{
+		{
+			name: "milliseconds",
+			json: `"2021-02-18T17:12:47.000Z"`,
+			want: time.Date(2021, 2, 18, 17, 12, 47, 0, time.UTC),
+		},
+		{
+			name: "seconds",
+			json: `"2021-07-06T18:40:40Z"`,
+			want: time.Date(2021, 7, 6, 18, 40, 40, 0, time.UTC),
+		},
+		{
+			name: "offset",
+			json: `"2021-07-06T20:40:40.123+02:00"`,
+			want: time.Date(2021, 7, 6, 18, 40, 40, 123000000, time.UTC),
+		},
+		{
+			name: "null",
+			json: `null`,
+		},
+	}
+	for i, tt := range tests {
+		i := i
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var got gotwtr.Time
+			if err := json.Unmarshal([]byte(tt.json), &got); err != nil {
+				t.Fatalf("Time.UnmarshalJSON() index = %v error = %v", i, err)
+			}
+			if !got.Time.Equal(tt.want) {
+				t.Errorf("Time.UnmarshalJSON() index = %v = %v, want %v", i, got.Time, tt.want)
+			}
+			b, err := json.Marshal(got)
+			if err != nil {
+				t.Fatalf("Time.MarshalJSON() index = %v error = %v", i, err)
+			}
+			if diff := cmp.Diff(tt.json, string(b)); diff != "" {
+				t.Errorf("Time.MarshalJSON() index = %v mismatch (-want +got):\n%s", i, diff)
+			}
+		})
+	}
+}
+
+func Test_NewTime(t *testing.T) {
+	t.Parallel()
+	tm := gotwtr.NewTime(time.Date(2021, 7, 6, 20, 40, 40, 0, time.FixedZone("CEST", 2*60*60)))
+	if got, want := tm.String(), "2021-07-06T18:40:40.000Z"; got != want {
+		t.Errorf("Time.String() = %q, want %q", got, want)
+	}
+	if !tm.Equal(mustParseTime("2021-07-06T18:40:40Z")) {
+		t.Errorf("Time.Equal() = false, want true")
+	}
+	if _, err := gotwtr.ParseTime("yesterday"); err == nil {
+		t.Errorf("ParseTime() error = nil, want an error")
+	}
+}
@@ -12,7 +12,7 @@ func userTweetTimeline(ctx context.Context, c *client, userID string, opt ...*Us
 	if userID == "" {
 		return nil, errors.New("user tweet timeline: id parameter is required")
 	}
-	ep := fmt.Sprintf(userTweetTimelineURL, userID)
+	ep := c.baseURL + fmt.Sprintf(userTweetTimelineURL, userID)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ep, nil)
 	if err != nil {
@@ -42,7 +42,7 @@ func userTweetTimeline(ctx context.Context, c *client, userID string, opt ...*Us
 	}
 	uopt.addQuery(req)
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user tweet timeline response: %w", err)
 	}
@@ -53,11 +53,7 @@ func userTweetTimeline(ctx context.Context, c *client, userID string, opt ...*Us
 		return nil, fmt.Errorf("user tweet timeline decode: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &timelines, &HTTPError{
-			APIName: "user tweet timeline",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &timelines, newAPIError("user tweet timeline", req, resp)
 	}
 
 	return &timelines, nil
@@ -67,7 +63,7 @@ func userMentionTimeline(ctx context.Context, c *client, userID string, opt ...*
 	if userID == "" {
 		return nil, errors.New("user mention timeline: userID parameter is required")
 	}
-	ep := fmt.Sprintf(userMentionTimelineURL, userID)
+	ep := c.baseURL + fmt.Sprintf(userMentionTimelineURL, userID)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ep, nil)
 	if err != nil {
@@ -97,7 +93,7 @@ func userMentionTimeline(ctx context.Context, c *client, userID string, opt ...*
 	}
 	uopt.addQuery(req)
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user mention timeline response: %w", err)
 	}
@@ -108,11 +104,54 @@ func userMentionTimeline(ctx context.Context, c *client, userID string, opt ...*
 		return nil, fmt.Errorf("user mention timeline decode: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &timelines, &HTTPError{
-			APIName: "user mention timeline",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &timelines, newAPIError("user mention timeline", req, resp)
+	}
+
+	return &timelines, nil
+}
+
+func homeTimeline(ctx context.Context, c *client, userID string, opt ...*HomeTimelineOption) (*HomeTimelineResponse, error) {
+	if userID == "" {
+		return nil, errors.New("home timeline: id parameter is required")
+	}
+	ep := c.baseURL + fmt.Sprintf(homeTimelineURL, userID)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ep, nil)
+	if err != nil {
+		return nil, fmt.Errorf("home timeline new request with ctx: %w", err)
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.bearerToken))
+
+	var hopt HomeTimelineOption
+	switch len(opt) {
+	case 0:
+		// do nothing
+	case 1:
+		hopt = *opt[0]
+	default:
+		return nil, errors.New("home timeline: only one option is allowed")
+	}
+	const (
+		minimumMaxResults = 1
+		maximumMaxResults = 100
+	)
+	if hopt.MaxResults != 0 && (hopt.MaxResults < minimumMaxResults || hopt.MaxResults > maximumMaxResults) {
+		return nil, fmt.Errorf("home timeline: max results must be between %d and %d", minimumMaxResults, maximumMaxResults)
+	}
+	hopt.addQuery(req)
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("home timeline response: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var timelines HomeTimelineResponse
+	if err := json.NewDecoder(resp.Body).Decode(&timelines); err != nil {
+		return nil, fmt.Errorf("home timeline decode: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return &timelines, newAPIError("home timeline", req, resp)
 	}
 
 	return &timelines, nil
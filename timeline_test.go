@@ -191,7 +191,7 @@ func Test_userTweetTimeline(t *testing.T) {
 						Text:           "💡 Using Twitter data for academic research? Join our next livestream this Friday @ 9am PT on https://t.co/GrtBOXh5Y1!\n \n@SuhemParack will show how to get started with recent search &amp; filtered stream endpoints on the #TwitterAPI v2, the new Tweet payload, annotations, &amp; more. https://t.co/IraD2Z7wEg",
 						AuthorID:       "2244994945",
 						ConversationID: "1338971066773905408",
-						CreatedAt:      "2020-12-15T22:15:53.000Z",
+						CreatedAt:      mustParseTime("2020-12-15T22:15:53.000Z"),
 						ContextAnnotations: []*gotwtr.TweetContextAnnotation{
 							{
 								Domain: &gotwtr.TweetContextObj{
@@ -217,7 +217,7 @@ func Test_userTweetTimeline(t *testing.T) {
 						Text:           "📈 Live now with @jessicagarson and @i_am_daniele! https://t.co/Y1AFzsTTxb",
 						AuthorID:       "2244994945",
 						ConversationID: "1338923691497959425",
-						CreatedAt:      "2020-12-15T19:07:38.000Z",
+						CreatedAt:      mustParseTime("2020-12-15T19:07:38.000Z"),
 						ContextAnnotations: []*gotwtr.TweetContextAnnotation{
 							{
 								Domain: &gotwtr.TweetContextObj{
@@ -633,7 +633,7 @@ func Test_userMentionTimeline(t *testing.T) {
 								},
 							},
 							UserName:  "LeStaache",
-							CreatedAt: "2018-08-27T18:34:07.000Z",
+							CreatedAt: mustParseTime("2018-08-27T18:34:07.000Z"),
 						},
 						{
 							Name: "dan dale",
@@ -657,7 +657,7 @@ func Test_userMentionTimeline(t *testing.T) {
 								},
 							},
 							UserName:  "LeBraat",
-							CreatedAt: "2010-10-07T05:36:28.000Z",
+							CreatedAt: mustParseTime("2010-10-07T05:36:28.000Z"),
 						},
 						{
 							Name: "LeGuud",
@@ -676,7 +676,7 @@ func Test_userMentionTimeline(t *testing.T) {
 								},
 							},
 							UserName:  "LeGuud",
-							CreatedAt: "2017-11-14T19:54:12.000Z",
+							CreatedAt: mustParseTime("2017-11-14T19:54:12.000Z"),
 						},
 					},
 				},
@@ -707,3 +707,125 @@ func Test_userMentionTimeline(t *testing.T) {
 		})
 	}
 }
+
+func Test_homeTimeline(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		ctx    context.Context
+		client *http.Client
+		id     string
+		opt    []*gotwtr.HomeTimelineOption
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *gotwtr.HomeTimelineResponse
+		wantErr bool
+	}{
+		{
+			name: "200 success",
+			args: args{
+				ctx: context.Background(),
+				client: mockHTTPClient(func(req *http.Request) *http.Response {
+					if req.URL.Path != "/2/users/2244994945/timelines/reverse_chronological" {
+						t.Errorf("the path is not correct got %s", req.URL.Path)
+					}
+					wantQuery := "exclude=replies&max_results=2&since_id=1524468552404668416"
+					if req.URL.RawQuery != wantQuery {
+						t.Errorf("the query is not correct got %s want %s", req.URL.RawQuery, wantQuery)
+					}
+					body := `{
+						"data": [
+							{
+								"id": "1524796546306478083",
+								"text": "Today marks the launch of Devs in the Details, a technical video series made for developers by developers building with the Twitter API."
+							},
+							{
+								"id": "1524468552404668416",
+								"text": "📢 Join @jessicagarson @alanbenlee and @i_am_daniele tomorrow, May 12 | 5:30 ET / 2:30pm PT as they discuss the future of bots"
+							}
+						],
+						"meta": {
+							"result_count": 2,
+							"newest_id": "1524796546306478083",
+							"oldest_id": "1524468552404668416",
+							"next_token": "7140dibdnow9c7btw421dyz6jism75z99gyxd8egarsc4"
+						}
+					}`
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       io.NopCloser(strings.NewReader(body)),
+					}
+				}),
+				id: "2244994945",
+				opt: []*gotwtr.HomeTimelineOption{
+					{
+						Exclude:    []gotwtr.Exclude{gotwtr.ExcludeReplies},
+						MaxResults: 2,
+						SinceID:    "1524468552404668416",
+					},
+				},
+			},
+			want: &gotwtr.HomeTimelineResponse{
+				Tweets: []*gotwtr.Tweet{
+					{
+						ID:   "1524796546306478083",
+						Text: "Today marks the launch of Devs in the Details, a technical video series made for developers by developers building with the Twitter API.",
+					},
+					{
+						ID:   "1524468552404668416",
+						Text: "📢 Join @jessicagarson @alanbenlee and @i_am_daniele tomorrow, May 12 | 5:30 ET / 2:30pm PT as they discuss the future of bots",
+					},
+				},
+				Meta: &gotwtr.UserTimelineMeta{
+					ResultCount: 2,
+					NewestID:    "1524796546306478083",
+					OldestID:    "1524468552404668416",
+					NextToken:   "7140dibdnow9c7btw421dyz6jism75z99gyxd8egarsc4",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "401 unauthorized",
+			args: args{
+				ctx: context.Background(),
+				client: mockHTTPClient(func(req *http.Request) *http.Response {
+					body := `{
+						"title": "Unauthorized",
+						"type": "about:blank",
+						"status": 401,
+						"detail": "Unauthorized"
+					}`
+					return &http.Response{
+						StatusCode: http.StatusUnauthorized,
+						Body:       io.NopCloser(strings.NewReader(body)),
+					}
+				}),
+				id: "2244994945",
+			},
+			want: &gotwtr.HomeTimelineResponse{
+				Title:  "Unauthorized",
+				Type:   "about:blank",
+				Detail: "Unauthorized",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c := gotwtr.New("key", gotwtr.WithHTTPClient(tt.args.client))
+			got, err := c.HomeTimeline(tt.args.ctx, tt.args.id, tt.args.opt...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("client.HomeTimeline() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("client.HomeTimeline() mismatch (-want +got):\n%s", diff)
+				return
+			}
+		})
+	}
+}
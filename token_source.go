@@ -0,0 +1,114 @@
+package gotwtr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// tokenExpiryDelta is how long before its expiry an access token is refreshed,
+// so that it does not expire while the request is in flight.
+const tokenExpiryDelta = 30 * time.Second
+
+// ErrNoToken is returned by a token source when its store holds no token yet.
+var ErrNoToken = errors.New("no oauth2 token")
+
+// TokenSource provides the access token sent with every request.
+type TokenSource interface {
+	Token(ctx context.Context) (*OAuth2Token, error)
+}
+
+// TokenStore persists the OAuth 2.0 token of a user, so that rotated refresh tokens survive a restart.
+type TokenStore interface {
+	// Load returns the stored token, or nil if there is none.
+	Load(ctx context.Context) (*OAuth2Token, error)
+	Save(ctx context.Context, token *OAuth2Token) error
+}
+
+// MemoryTokenStore is a TokenStore which keeps the token in memory.
+type MemoryTokenStore struct {
+	mu    sync.Mutex
+	token *OAuth2Token
+}
+
+// NewMemoryTokenStore returns a MemoryTokenStore holding token, which may be nil.
+func NewMemoryTokenStore(token *OAuth2Token) *MemoryTokenStore {
+	return &MemoryTokenStore{token: token}
+}
+
+func (s *MemoryTokenStore) Load(ctx context.Context) (*OAuth2Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token == nil {
+		return nil, nil
+	}
+	t := *s.token
+	return &t, nil
+}
+
+func (s *MemoryTokenStore) Save(ctx context.Context, token *OAuth2Token) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t := *token
+	s.token = &t
+	return nil
+}
+
+// refreshingTokenSource loads the token from a store and refreshes it shortly before it expires.
+type refreshingTokenSource struct {
+	c     *client
+	cfg   *OAuth2Config
+	store TokenStore
+
+	mu    sync.Mutex
+	token *OAuth2Token
+}
+
+func (s *refreshingTokenSource) Token(ctx context.Context) (*OAuth2Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token == nil {
+		t, err := s.store.Load(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("load oauth2 token: %w", err)
+		}
+		if t == nil {
+			return nil, ErrNoToken
+		}
+		s.token = t
+	}
+	if !s.token.expired(tokenExpiryDelta) || s.token.RefreshToken == "" {
+		return s.token, nil
+	}
+	t, err := refreshOAuth2Token(ctx, s.c, s.cfg, s.token.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.store.Save(ctx, t); err != nil {
+		return nil, fmt.Errorf("save oauth2 token: %w", err)
+	}
+	s.token = t
+	return t, nil
+}
+
+// WithTokenSource makes the client send the access token of ts instead of the bearer token given to New.
+func WithTokenSource(ts TokenSource) ClientOption {
+	return func(c *client) {
+		c.auth = NewOAuth2Authenticator(ts)
+	}
+}
+
+// WithOAuth2Token makes the client act on behalf of the user whose token is in store.
+// The access token is refreshed with cfg before it expires, and the rotated token is saved to store.
+// The token has to be obtained once with OAuth2Config.AuthCodeURL and ExchangeOAuth2Code.
+func WithOAuth2Token(cfg *OAuth2Config, store TokenStore) ClientOption {
+	return func(c *client) {
+		c.auth = NewOAuth2Authenticator(&refreshingTokenSource{
+			c:     c,
+			cfg:   cfg,
+			store: store,
+		})
+	}
+}
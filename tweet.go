@@ -1,10 +1,5 @@
 package gotwtr
 
-import (
-	"net/http"
-	"sync"
-)
-
 type TweetField string
 
 /*
@@ -46,7 +41,7 @@ type Tweet struct {
 	AuthorID           string                    `json:"author_id,omitempty"`
 	ContextAnnotations []*TweetContextAnnotation `json:"context_annotations,omitempty"`
 	ConversationID     string                    `json:"conversation_id,omitempty"`
-	CreatedAt          string                    `json:"created_at"`
+	CreatedAt          Time                      `json:"created_at"`
 	Entities           *TweetEntity              `json:"entities,omitempty"`
 	Geo                *TweetGeo                 `json:"geo,omitempty"`
 	InReplyToUserID    string                    `json:"in_reply_to_user_id,omitempty"`
@@ -60,6 +55,7 @@ type Tweet struct {
 	ReplySettings      string                    `json:"reply_settings,omitempty"`
 	Source             string                    `json:"source,omitempty"`
 	Withheld           *TweetWithheld            `json:"withheld,omitempty"`
+	EditControls       *TweetEditControls        `json:"edit_controls,omitempty"`
 }
 
 type TweetAttachment struct {
@@ -109,7 +105,8 @@ type TweetHashtag struct {
 type TweetMention struct {
 	Start    int    `json:"start"`
 	End      int    `json:"end"`
-	UserName string `json:"user_name"`
+	UserName string `json:"username"`
+	ID       string `json:"id,omitempty"`
 }
 
 type TweetURL struct {
@@ -159,6 +156,13 @@ type TweetReferencedTweet struct {
 type TweetWithheld struct {
 	Copyright    bool     `json:"copyright"`
 	CountryCodes []string `json:"country_codes"`
+	Scope        string   `json:"scope,omitempty"`
+}
+
+type TweetEditControls struct {
+	EditsRemaining int  `json:"edits_remaining"`
+	IsEditEligible bool `json:"is_edit_eligible"`
+	EditableUntil  Time `json:"editable_until"`
 }
 
 type TweetsResponse struct {
@@ -197,6 +201,16 @@ type UserTweetTimelineResponse struct {
 	Type     string              `json:"type,omitempty"`
 }
 
+type HomeTimelineResponse struct {
+	Tweets   []*Tweet            `json:"data"`
+	Includes *TweetIncludes      `json:"includes,omitempty"`
+	Errors   []*APIResponseError `json:"errors,omitempty"`
+	Meta     *UserTimelineMeta   `json:"meta"`
+	Title    string              `json:"title,omitempty"`
+	Detail   string              `json:"detail,omitempty"`
+	Type     string              `json:"type,omitempty"`
+}
+
 type UserMentionTimelineResponse struct {
 	Tweets   []*Tweet            `json:"data"`
 	Includes *TweetIncludes      `json:"includes,omitempty"`
@@ -232,9 +246,9 @@ type SearchTweetsMeta struct {
 }
 
 type TimeseriesCount struct {
-	Start      string `json:"start"`
-	End        string `json:"end"`
-	TweetCount int    `json:"tweet_count"`
+	Start      Time `json:"start"`
+	End        Time `json:"end"`
+	TweetCount int  `json:"tweet_count"`
 }
 
 type TweetCountMeta struct {
@@ -261,6 +275,21 @@ type RetweetsLookupMeta struct {
 	ResultCount int `json:"result_count"`
 }
 
+type QuoteTweetsResponse struct {
+	Tweets   []*Tweet            `json:"data"`
+	Includes *TweetIncludes      `json:"includes,omitempty"`
+	Errors   []*APIResponseError `json:"errors,omitempty"`
+	Meta     *QuoteTweetsMeta    `json:"meta"`
+	Title    string              `json:"title,omitempty"`
+	Detail   string              `json:"detail,omitempty"`
+	Type     string              `json:"type,omitempty"`
+}
+
+type QuoteTweetsMeta struct {
+	ResultCount int    `json:"result_count"`
+	NextToken   string `json:"next_token"`
+}
+
 type RetrieveStreamRulesResponse struct {
 	Rules  []*FilteredRule          `json:"data"`
 	Meta   *RetrieveStreamRulesMeta `json:"meta"`
@@ -274,7 +303,7 @@ type FilteredRule struct {
 }
 
 type RetrieveStreamRulesMeta struct {
-	Sent string
+	Sent Time
 }
 
 type AddOrDeleteJSONBody struct {
@@ -298,10 +327,20 @@ type AddOrDeleteRulesResponse struct {
 }
 
 type AddOrDeleteRulesMeta struct {
-	Sent    string                  `json:"sent"`
+	Sent    Time                    `json:"sent"`
 	Summary *AddOrDeleteMetaSummary `json:"summary"`
 }
 
+// SyncStreamRulesReport describes what SyncStreamRules changed, or would change in a dry run.
+type SyncStreamRulesReport struct {
+	DryRun    bool
+	Added     []*FilteredRule
+	Deleted   []*FilteredRule
+	Unchanged []*FilteredRule
+	// Errors holds the rules Twitter rejected.
+	Errors []*APIResponseError
+}
+
 type AddOrDeleteMetaSummary struct {
 	Created    int `json:"created"`
 	NotCreated int `json:"not_created"`
@@ -315,6 +354,8 @@ type ConnectToStreamResponse struct {
 	Tweet         *Tweet          `json:"data"`
 	Includes      *TweetIncludes  `json:"includes,omitempty"`
 	MatchingRules []*MatchingRule `json:"matching_rules"`
+	// Errors holds the reason of an operational disconnect.
+	Errors []*APIResponseError `json:"errors,omitempty"`
 }
 
 type MatchingRule struct {
@@ -322,12 +363,45 @@ type MatchingRule struct {
 	Tag string `json:"tag"`
 }
 
+func (r ConnectToStreamResponse) disconnectErrors() []*APIResponseError {
+	if r.Tweet != nil {
+		return nil
+	}
+	return r.Errors
+}
+
+// ConnectToStream is a connection to the filtered stream. Stop closes it.
 type ConnectToStream struct {
-	client *http.Client
-	errCh  chan<- error
-	ch     chan<- ConnectToStreamResponse
-	done   chan struct{}
-	wg     *sync.WaitGroup
+	*stream[ConnectToStreamResponse]
+}
+
+// StreamState is the state of the connection of a stream.
+type StreamState int
+
+const (
+	// StreamConnecting is reported before every connection attempt.
+	StreamConnecting StreamState = iota
+	// StreamConnected is reported once Twitter accepted the connection.
+	StreamConnected
+	// StreamDisconnected is reported with the cause when the connection is lost. The stream reconnects after a backoff.
+	StreamDisconnected
+	// StreamStopped is reported when the stream ends, with the cause unless it was stopped by Stop or its context.
+	StreamStopped
+)
+
+func (s StreamState) String() string {
+	switch s {
+	case StreamConnecting:
+		return "connecting"
+	case StreamConnected:
+		return "connected"
+	case StreamDisconnected:
+		return "disconnected"
+	case StreamStopped:
+		return "stopped"
+	default:
+		return "unknown"
+	}
 }
 
 type PostRetweetResponse struct {
@@ -354,90 +428,23 @@ type VolumeStreamsResponse struct {
 	Errors   []*APIResponseError `json:"errors,omitempty"`
 }
 
-type VolumeStreams struct {
-	client *http.Client
-	errCh  chan<- error
-	ch     chan<- VolumeStreamsResponse
-	done   chan struct{}
-	wg     *sync.WaitGroup
+func (r VolumeStreamsResponse) disconnectErrors() []*APIResponseError {
+	if r.Tweet != nil {
+		return nil
+	}
+	return r.Errors
 }
 
-type LookUpUsersWhoLikedWithheld struct {
-	Scope        string   `json:"scope"`
-	CountryCodes []string `json:"country_codes"`
-}
-
-type LookUpUsersWhoLikedEntity struct {
-	URL         *LookUpUsersWhoLikedURL         `json:"url"`
-	Description *LookUpUsersWhoLikedDescription `json:"description"`
-}
-
-type LookUpUsersWhoLikedURL struct {
-	URLs []*LookUpUsersWhoLikedURLContent `json:"urls"`
-}
-
-type LookUpUsersWhoLikedDescription struct {
-	URLs     []*LookUpUsersWhoLikedURLContent `json:"urls"`
-	HashTags []*LookUpUsersWhoLikedHashTag    `json:"hashtags"`
-	Mentions []*LookUpUsersWhoLikedMention    `json:"mentions"`
-}
-
-type LookUpUsersWhoLikedURLContent struct {
-	Start       int    `json:"start"`
-	End         int    `json:"end"`
-	URL         string `json:"url"`
-	ExpandedURL string `json:"expanded_url"`
-	DisplayURL  string `json:"display_url"`
-}
-
-type LookUpUsersWhoLikedHashTag struct {
-	Start   int    `json:"start"`
-	End     int    `json:"end"`
-	HashTag string `json:"hashtag"`
-}
-
-type LookUpUsersWhoLikedMention struct {
-	Start    int                           `json:"start"`
-	End      int                           `json:"end"`
-	UserName string                        `json:"username"`
-	CashTags []*LookUpUsersWhoLikedCashTag `json:"cashtags"`
-}
-
-type LookUpUsersWhoLikedCashTag struct {
-	Start   int    `json:"start"`
-	End     int    `json:"end"`
-	CashTag string `json:"cashtag"`
-}
-
-type LookUpUsersWhoLikedPublicMetrics struct {
-	FollowersCount int `json:"followers_count"`
-	FollowingCount int `json:"following_count"`
-	TweetCount     int `json:"tweet_count"`
-	ListedCount    int `json:"listed_count"`
-}
-
-type LookUpUsersWhoLiked struct {
-	ID              string                            `json:"id"`
-	Name            string                            `json:"name"`
-	UserName        string                            `json:"username"`
-	CreatedAt       string                            `json:"created_at,omitempty"`
-	Protected       bool                              `json:"protected,omitempty"`
-	Withheld        *LookUpUsersWhoLikedWithheld      `json:"withheld,omitempty"`
-	Location        string                            `json:"location,omitempty"`
-	URL             string                            `json:"url,omitempty"`
-	Description     string                            `json:"description,omitempty"`
-	Verified        bool                              `json:"verified,omitempty"`
-	Entities        *LookUpUsersWhoLikedEntity        `json:"entities,omitempty"`
-	ProfileImageURL string                            `json:"profile_image_url,omitempty"`
-	PublicMetrics   *LookUpUsersWhoLikedPublicMetrics `json:"public_metrics,omitempty"`
-	PinnedTweetID   string                            `json:"pinned_tweet_id,omitempty"`
+// VolumeStreams is a connection to a sampled stream. Stop closes it.
+type VolumeStreams struct {
+	*stream[VolumeStreamsResponse]
 }
 
 type UsersLikingTweetResponse struct {
-	Users    []*LookUpUsersWhoLiked       `json:"data"`
-	Includes *LookUpUsersWhoLikedIncludes `json:"includes,omitempty"`
-	Meta     *LookUpUsersWhoLikedMeta     `json:"meta"`
-	Errors   []*APIResponseError          `json:"errors,omitempty"`
+	Users    []*User                  `json:"data"`
+	Includes *UserIncludes            `json:"includes,omitempty"`
+	Meta     *LookUpUsersWhoLikedMeta `json:"meta"`
+	Errors   []*APIResponseError      `json:"errors,omitempty"`
 }
 
 type PostUsersLikingTweetResponse struct {
@@ -458,158 +465,12 @@ type UsersLikingBody struct {
 	TweetID string `json:"tweet_id"`
 }
 
-type LookUpUsersWhoLikedIncludes struct {
-	Tweets []*Tweet `json:"tweets"`
-}
-
 type LookUpUsersWhoLikedMeta struct {
 	ResultCount int `json:"result_count"`
 }
 
-type TweetsUserLiked struct {
-	ID                 string                               `json:"id"`
-	Text               string                               `json:"text"`
-	CreatedAt          string                               `json:"created_at,omitempty"`
-	AuthorID           string                               `json:"author_id,omitempty"`
-	ConversationID     string                               `json:"conversation_id,omitempty"`
-	InReplyToUserID    string                               `json:"in_reply_to_user_id,omitempty"`
-	ReferencedTweets   []*TweetsUserLikedReferencedTweets   `json:"referenced_tweets,omitempty"`
-	Attachments        *TweetsUserLikedAttachments          `json:"attachments,omitempty"`
-	Geo                *TweetsUserLikedGeo                  `json:"geo,omitempty"`
-	ContextAnnotations []*TweetsUserLikedContextAnnotations `json:"context_annotations,omitempty"`
-	Entities           *TweetsUserLikedEntities             `json:"entities,omitempty"`
-	Withheld           *TweetsUserLikedWithheld             `json:"withheld,omitempty"`
-	PublicMetrics      *TweetsUserLikedPublicMetrics        `json:"public_metrics,omitempty"`
-	NonPublicMetrics   *TweetsUserLikedNonPublicMetrics     `json:"non_public_metrics,omitempty"` // requires the use of OAuth 1.0a User Context authentication.
-	OrganicMetrics     *TweetsUserLikedOrganicMetrics       `json:"organic_metrics,omitempty"`    // requires user context authentication.
-	PromotedMetrics    *TweetsUserLikedPromotedMetrics      `json:"promoted_metrics,omitempty"`   // requires user context authentication.
-	PossiblySensitive  bool                                 `json:"possibly_sensitive,omitempty"`
-	Lang               string                               `json:"lang,omitempty"`
-	ReplySettings      string                               `json:"reply_settings,omitempty"`
-	Source             string                               `json:"source,omitempty"`
-	EditHistoryIDs     []string                             `json:"edit_history_ids,omitempty"`
-	EditControls       *TweetsUserLikedEditControls         `json:"edit_controls,omitempty"`
-}
-
-type TweetsUserLikedReferencedTweets struct {
-	Type string `json:"type"`
-	ID   string `json:"id"`
-}
-
-type TweetsUserLikedAttachments struct {
-	MediaKeys []string `json:"media_keys"`
-	PollIDs   []string `json:"poll_ids"`
-}
-
-type TweetsUserLikedGeo struct {
-	Coordinates *TweetsUserLikedGeoCoordinates `json:"coordinates"`
-	PlaceID     string                         `json:"place_id"`
-}
-
-type TweetsUserLikedGeoCoordinates struct {
-	Type        string    `json:"type"`
-	Coordinates []float64 `json:"coordinates"`
-}
-
-type TweetsUserLikedContextAnnotations struct {
-	Domain *TweetsUserLikedContextAnnotationsDomain `json:"domain"`
-	Entity *TweetsUserLikedContextAnnotationsEntity `json:"entity"`
-}
-
-type TweetsUserLikedContextAnnotationsDomain struct {
-	ID          string `json:"id"`
-	Name        string `json:"name"`
-	Description string `json:"description"`
-}
-
-type TweetsUserLikedContextAnnotationsEntity struct {
-	ID          string `json:"id"`
-	Name        string `json:"name"`
-	Description string `json:"description"`
-}
-
-type TweetsUserLikedEntities struct {
-	Annotations []*TweetsUserLikedEntitiesAnnotation `json:"annotations"`
-	URLs        []*TweetsUserLikedEntitiesURLContent `json:"urls"`
-	HashTags    []*TweetsUserLikedEntitiesHashTag    `json:"hashtags"`
-	Mentions    []*TweetsUserLikedEntitiesMention    `json:"mentions"`
-	CashTags    []*TweetsUserLikedEntitiesCashTag    `json:"cashtags"`
-}
-
-type TweetsUserLikedEntitiesAnnotation struct {
-	Start          int    `json:"start"`
-	End            int    `json:"end"`
-	Probability    int    `json:"probability"`
-	Type           string `json:"type"`
-	NormalizedText string `json:"normalized_text"`
-}
-
-type TweetsUserLikedEntitiesURLContent struct {
-	Start       int    `json:"start"`
-	End         int    `json:"end"`
-	URL         string `json:"url"`
-	ExpandedURL string `json:"expanded_url"`
-	DisplayURL  string `json:"display_url"`
-	UnwoundURL  string `json:"unwound_url"`
-}
-
-type TweetsUserLikedEntitiesHashTag struct {
-	Start int    `json:"start"`
-	End   int    `json:"end"`
-	Tag   string `json:"tag"`
-}
-
-type TweetsUserLikedEntitiesMention struct {
-	Start    int    `json:"start"`
-	End      int    `json:"end"`
-	UserName string `json:"username"`
-}
-
-type TweetsUserLikedEntitiesCashTag struct {
-	Start int    `json:"start"`
-	End   int    `json:"end"`
-	Tag   string `json:"tag"`
-}
-
-type TweetsUserLikedWithheld struct {
-	Copyright    bool     `json:"copyright"`
-	CountryCodes []string `json:"country_codes"`
-	Scope        string   `json:"scope"`
-}
-
-type TweetsUserLikedPublicMetrics struct {
-	RetweetCount int `json:"retweet_count"`
-	ReplyCount   int `json:"reply_count"`
-	LikeCount    int `json:"like_count"`
-	QuoteCount   int `json:"quote_count"`
-}
-
-type TweetsUserLikedNonPublicMetrics struct {
-	ImpressionCount   int `json:"impression_count"`
-	URLLinkClicks     int `json:"url_link_clicks"`
-	UserProfileClicks int `json:"user_profile_clicks"`
-}
-
-type TweetsUserLikedOrganicMetrics struct {
-	ImpressionCount   int `json:"impression_count"`    // requires the use of OAuth 1.0a User Context authentication.
-	URLLinkClicks     int `json:"url_link_clicks"`     // requires the use of OAuth 1.0a User Context authentication.
-	UserProfileClicks int `json:"user_profile_clicks"` // requires the use of OAuth 1.0a User Context authentication.
-	RetweetCount      int `json:"retweet_count"`
-	ReplyCount        int `json:"reply_count"`
-	LikeCount         int `json:"like_count"`
-}
-
-type TweetsUserLikedPromotedMetrics struct {
-	ImpressionCount   int `json:"impression_count"`    // requires the use of OAuth 1.0a User Context authentication.
-	URLLinkClicks     int `json:"url_link_clicks"`     // requires the use of OAuth 1.0a User Context authentication.
-	UserProfileClicks int `json:"user_profile_clicks"` // requires the use of OAuth 1.0a User Context authentication.
-	RetweetCount      int `json:"retweet_count"`
-	ReplyCount        int `json:"reply_count"`
-	LikeCount         int `json:"like_count"`
-}
-
 type TweetsUserLikedResponse struct {
-	Tweets   []*TweetsUserLiked   `json:"data"`
+	Tweets   []*Tweet             `json:"data"`
 	Includes *TweetIncludes       `json:"includes,omitempty"`
 	Meta     *TweetsUserLikedMeta `json:"meta"`
 	Errors   []*APIResponseError  `json:"errors,omitempty"`
@@ -693,9 +554,3 @@ type RemoveBookmarkOfTweetResponse struct {
 type RemoveBookmarkOfTweetData struct {
 	Bookmarks bool `json:"bookmarks"`
 }
-
-type TweetsUserLikedEditControls struct {
-	EditRemaining  int    `json:"edit_remaining"`
-	IsEditEligible bool   `json:"is_edit_eligible"`
-	EditableUntil  string `json:"editable_until"`
-}
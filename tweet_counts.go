@@ -13,7 +13,7 @@ func countRecentTweets(ctx context.Context, c *client, tweet string, opt ...*Twe
 		return nil, errors.New("count of recent tweets: tweet parameter is required")
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, countsRecentTweetsURL, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+countsRecentTweetsURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("count of recent tweets new request with ctx: %w", err)
 	}
@@ -30,7 +30,7 @@ func countRecentTweets(ctx context.Context, c *client, tweet string, opt ...*Twe
 	}
 	topt.addQuery(req, tweet)
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("count of recent tweets: %w", err)
 	}
@@ -41,11 +41,7 @@ func countRecentTweets(ctx context.Context, c *client, tweet string, opt ...*Twe
 		return nil, fmt.Errorf("count of recent tweets: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &tcr, &HTTPError{
-			APIName: "count of recent tweets",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &tcr, newAPIError("count of recent tweets", req, resp)
 	}
 
 	return &tcr, nil
@@ -56,7 +52,7 @@ func countAllTweets(ctx context.Context, c *client, tweet string, opt ...*TweetC
 		return nil, errors.New("count of all tweets: tweet parameter is required")
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, countsAllTweetsURL, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+countsAllTweetsURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("count of all tweets new request with ctx: %w", err)
 	}
@@ -73,7 +69,7 @@ func countAllTweets(ctx context.Context, c *client, tweet string, opt ...*TweetC
 	}
 	topt.addQuery(req, tweet)
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("count of all tweets: %w", err)
 	}
@@ -84,11 +80,7 @@ func countAllTweets(ctx context.Context, c *client, tweet string, opt ...*TweetC
 		return nil, fmt.Errorf("count of all tweets: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &tcr, &HTTPError{
-			APIName: "count of all tweets",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &tcr, newAPIError("count of all tweets", req, resp)
 	}
 	return &tcr, nil
 }
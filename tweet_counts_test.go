@@ -66,13 +66,13 @@ func Test_client_TweetCounts(t *testing.T) {
 			want: &gotwtr.TweetCountsResponse{
 				Counts: []*gotwtr.TimeseriesCount{
 					{
-						Start:      "2021-09-27T14:00:00.000Z",
-						End:        "2021-09-27T15:00:00.000Z",
+						Start:      mustParseTime("2021-09-27T14:00:00.000Z"),
+						End:        mustParseTime("2021-09-27T15:00:00.000Z"),
 						TweetCount: 2,
 					},
 					{
-						Start:      "2021-09-27T15:00:00.000Z",
-						End:        "2021-09-27T16:00:00.000Z",
+						Start:      mustParseTime("2021-09-27T15:00:00.000Z"),
+						End:        mustParseTime("2021-09-27T16:00:00.000Z"),
 						TweetCount: 2,
 					},
 				},
@@ -121,13 +121,13 @@ func Test_client_TweetCounts(t *testing.T) {
 			want: &gotwtr.TweetCountsResponse{
 				Counts: []*gotwtr.TimeseriesCount{
 					{
-						Start:      "2021-09-27T00:00:00.000Z",
-						End:        "2021-09-28T00:00:00.000Z",
+						Start:      mustParseTime("2021-09-27T00:00:00.000Z"),
+						End:        mustParseTime("2021-09-28T00:00:00.000Z"),
 						TweetCount: 4,
 					},
 					{
-						Start:      "2021-09-28T00:00:00.000Z",
-						End:        "2021-09-29T00:00:00.000Z",
+						Start:      mustParseTime("2021-09-28T00:00:00.000Z"),
+						End:        mustParseTime("2021-09-29T00:00:00.000Z"),
 						TweetCount: 3,
 					},
 				},
@@ -186,13 +186,13 @@ func Test_countAllTweets(t *testing.T) {
 			want: &gotwtr.TweetCountsResponse{
 				Counts: []*gotwtr.TimeseriesCount{
 					{
-						Start:      "2021-09-27T13:00:00.000Z",
-						End:        "2021-09-27T14:00:00.000Z",
+						Start:      mustParseTime("2021-09-27T13:00:00.000Z"),
+						End:        mustParseTime("2021-09-27T14:00:00.000Z"),
 						TweetCount: 2,
 					},
 					{
-						Start:      "2021-09-27T14:00:00.000Z",
-						End:        "2021-09-27T15:00:00.000Z",
+						Start:      mustParseTime("2021-09-27T14:00:00.000Z"),
+						End:        mustParseTime("2021-09-27T15:00:00.000Z"),
 						TweetCount: 2,
 					},
 				},
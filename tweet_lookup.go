@@ -16,7 +16,7 @@ func retrieveMultipleTweets(ctx context.Context, c *client, tweetIDs []string, o
 		return nil, errors.New("retrieve multiple tweets: tweet ids parameter must be less than or equal to 100")
 	default:
 	}
-	ep := retrieveMultipleTweetsURL
+	ep := c.baseURL + retrieveMultipleTweetsURL
 	for i, tid := range tweetIDs {
 		if i+1 < len(tweetIDs) {
 			ep += fmt.Sprintf("%s,", tid)
@@ -42,7 +42,7 @@ func retrieveMultipleTweets(ctx context.Context, c *client, tweetIDs []string, o
 	}
 	ropt.addQuery(req)
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("retrieve multiple tweets response: %w", err)
 	}
@@ -53,11 +53,7 @@ func retrieveMultipleTweets(ctx context.Context, c *client, tweetIDs []string, o
 		return nil, fmt.Errorf("retrieve multiple tweets: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &tweet, &HTTPError{
-			APIName: "retrieve multiple tweets",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &tweet, newAPIError("retrieve multiple tweets", req, resp)
 	}
 
 	return &tweet, nil
@@ -67,7 +63,7 @@ func retrieveSingleTweet(ctx context.Context, c *client, tweetID string, opt ...
 	if tweetID == "" {
 		return nil, errors.New("retrieve single tweet: tweet id parameter is required")
 	}
-	ep := fmt.Sprintf(retrieveSingleTweetURL, tweetID)
+	ep := c.baseURL + fmt.Sprintf(retrieveSingleTweetURL, tweetID)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ep, nil)
 	if err != nil {
@@ -87,7 +83,7 @@ func retrieveSingleTweet(ctx context.Context, c *client, tweetID string, opt ...
 	}
 	ropt.addQuery(req)
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("retrieve single tweet response: %w", err)
 	}
@@ -99,11 +95,7 @@ func retrieveSingleTweet(ctx context.Context, c *client, tweetID string, opt ...
 		return nil, fmt.Errorf("retrieve single tweet: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &tweet, &HTTPError{
-			APIName: "retrieve single tweet",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &tweet, newAPIError("retrieve single tweet", req, resp)
 	}
 
 	return &tweet, nil
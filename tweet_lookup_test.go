@@ -113,7 +113,7 @@ func Test_retriveMultipleTweets(t *testing.T) {
 					{
 						AuthorID:  "11111111",
 						ID:        "123456789",
-						CreatedAt: "2020-01-01T00:00:00Z",
+						CreatedAt: mustParseTime("2020-01-01T00:00:00Z"),
 						Text:      "Hello, world!",
 					},
 				},
@@ -233,13 +233,13 @@ func Test_retriveMultipleTweets(t *testing.T) {
 					{
 						AuthorID:  "11111111",
 						ID:        "123456789",
-						CreatedAt: "2020-01-01T00:00:00Z",
+						CreatedAt: mustParseTime("2020-01-01T00:00:00Z"),
 						Text:      "Hello, world!",
 					},
 					{
 						AuthorID:  "22222222",
 						ID:        "987654321",
-						CreatedAt: "2020-01-02T00:00:00Z",
+						CreatedAt: mustParseTime("2020-01-02T00:00:00Z"),
 						Text:      "Hello, Go!",
 					},
 				},
@@ -513,7 +513,7 @@ func Test_lookUpByID(t *testing.T) {
 			want: &gotwtr.TweetResponse{
 				Tweet: &gotwtr.Tweet{
 					AuthorID:  "2244994945",
-					CreatedAt: "2020-06-24T16:28:14.000Z",
+					CreatedAt: mustParseTime("2020-06-24T16:28:14.000Z"),
 					Entities: &gotwtr.TweetEntity{
 						URLs: []*gotwtr.TweetURL{
 							{
@@ -796,7 +796,7 @@ func Test_lookUpByID(t *testing.T) {
 				Includes: &gotwtr.TweetIncludes{
 					Polls: []*gotwtr.Poll{
 						{
-							EndDatetime: "2019-11-28T20:26:41.000Z",
+							EndDatetime: mustParseTime("2019-11-28T20:26:41.000Z"),
 							Options: []*gotwtr.PollOption{
 								{
 									Position: 1,
@@ -912,7 +912,7 @@ func Test_lookUpByID(t *testing.T) {
 						},
 					},
 					ID:        "1263145271946551300",
-					CreatedAt: "2020-05-20T16:31:15.000Z",
+					CreatedAt: mustParseTime("2020-05-20T16:31:15.000Z"),
 					Lang:      "en",
 					Entities: &gotwtr.TweetEntity{
 						URLs: []*gotwtr.TweetURL{
@@ -5,6 +5,8 @@ import (
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/sivchari/gotwtr/snowflake"
 )
 
 type RetriveTweetOption struct {
@@ -103,6 +105,68 @@ func (u UserTweetTimelineOption) addQuery(req *http.Request) {
 	}
 }
 
+type HomeTimelineOption struct {
+	EndTime         time.Time
+	Exclude         []Exclude
+	Expansions      []Expansion
+	MaxResults      int
+	MediaFields     []MediaField
+	PaginationToken string
+	PlaceFields     []PlaceField
+	PollFields      []PollField
+	SinceID         string
+	StartTime       time.Time
+	TweetFields     []TweetField
+	UntilID         string
+	UserFields      []UserField
+}
+
+func (h HomeTimelineOption) addQuery(req *http.Request) {
+	q := req.URL.Query()
+	if !h.EndTime.IsZero() {
+		q.Add("end_time", h.EndTime.Format(time.RFC3339))
+	}
+	if len(h.Exclude) > 0 {
+		q.Add("exclude", strings.Join(excludeToString(h.Exclude), ","))
+	}
+	if len(h.Expansions) > 0 {
+		q.Add("expansions", strings.Join(expansionsToString(h.Expansions), ","))
+	}
+	if h.MaxResults > 0 {
+		q.Add("max_results", strconv.Itoa(h.MaxResults))
+	}
+	if len(h.MediaFields) > 0 {
+		q.Add("media.fields", strings.Join(mediaFieldsToString(h.MediaFields), ","))
+	}
+	if h.PaginationToken != "" {
+		q.Add("pagination_token", h.PaginationToken)
+	}
+	if len(h.PlaceFields) > 0 {
+		q.Add("place.fields", strings.Join(placeFieldsToString(h.PlaceFields), ","))
+	}
+	if len(h.PollFields) > 0 {
+		q.Add("poll.fields", strings.Join(pollFieldsToString(h.PollFields), ","))
+	}
+	if h.SinceID != "" {
+		q.Add("since_id", h.SinceID)
+	}
+	if !h.StartTime.IsZero() {
+		q.Add("start_time", h.StartTime.Format(time.RFC3339))
+	}
+	if len(h.TweetFields) > 0 {
+		q.Add("tweet.fields", strings.Join(tweetFieldsToString(h.TweetFields), ","))
+	}
+	if h.UntilID != "" {
+		q.Add("until_id", h.UntilID)
+	}
+	if len(h.UserFields) > 0 {
+		q.Add("user.fields", strings.Join(userFieldsToString(h.UserFields), ","))
+	}
+	if len(q) > 0 {
+		req.URL.RawQuery = q.Encode()
+	}
+}
+
 type UserMentionTimelineOption struct {
 	EndTime         time.Time
 	Expansions      []Expansion
@@ -304,6 +368,10 @@ func (t *AddOrDeleteRulesOption) addQuery(req *http.Request) {
 	}
 }
 
+type SyncStreamRulesOption struct {
+	DryRun bool // If it is true, validate the changes without applying them
+}
+
 type RetrieveStreamRulesOption struct {
 	IDs []string
 }
@@ -319,13 +387,31 @@ func (t *RetrieveStreamRulesOption) addQuery(req *http.Request) {
 }
 
 type ConnectToStreamOption struct {
-	// BackfillMinutes int // This feature is currently only available to the Academic Research product track.
-	Expansions  []Expansion
-	MediaFields []MediaField
-	PlaceFields []PlaceField
-	PollFields  []PollField
-	TweetFields []TweetField
-	UserFields  []UserField
+	// BackfillMinutes recovers up to 5 minutes of Tweets missed while disconnected. It is only sent when reconnecting.
+	// This feature is currently only available to the Academic Research product track.
+	BackfillMinutes int
+	Expansions      []Expansion
+	MediaFields     []MediaField
+	PlaceFields     []PlaceField
+	PollFields      []PollField
+	TweetFields     []TweetField
+	UserFields      []UserField
+	// DisableReconnect stops the stream at the first disconnection instead of reconnecting.
+	DisableReconnect bool
+	// StallTimeout is how long the stream may stay silent before it is considered stalled and reconnected.
+	// Twitter sends a keep-alive newline every 20 seconds. Zero means 30 seconds.
+	StallTimeout time.Duration
+	// OnStateChange is called from the stream goroutine on every connection state transition.
+	OnStateChange func(state StreamState, err error)
+}
+
+func (t *ConnectToStreamOption) settings() streamSettings {
+	return streamSettings{
+		backfillMinutes:  t.BackfillMinutes,
+		disableReconnect: t.DisableReconnect,
+		stallTimeout:     t.StallTimeout,
+		onStateChange:    t.OnStateChange,
+	}
 }
 
 func (t *ConnectToStreamOption) addQuery(req *http.Request) {
@@ -354,13 +440,31 @@ func (t *ConnectToStreamOption) addQuery(req *http.Request) {
 }
 
 type VolumeStreamsOption struct {
-	// BackfillMinutes int // This feature is currently only available to the Academic Research product track.
-	Expansions  []Expansion
-	MediaFields []MediaField
-	PlaceFields []PlaceField
-	PollFields  []PollField
-	TweetFields []TweetField
-	UserFields  []UserField
+	// BackfillMinutes recovers up to 5 minutes of Tweets missed while disconnected. It is only sent when reconnecting.
+	// This feature is currently only available to the Academic Research product track.
+	BackfillMinutes int
+	Expansions      []Expansion
+	MediaFields     []MediaField
+	PlaceFields     []PlaceField
+	PollFields      []PollField
+	TweetFields     []TweetField
+	UserFields      []UserField
+	// DisableReconnect stops the stream at the first disconnection instead of reconnecting.
+	DisableReconnect bool
+	// StallTimeout is how long the stream may stay silent before it is considered stalled and reconnected.
+	// Twitter sends a keep-alive newline every 20 seconds. Zero means 30 seconds.
+	StallTimeout time.Duration
+	// OnStateChange is called from the stream goroutine on every connection state transition.
+	OnStateChange func(state StreamState, err error)
+}
+
+func (v VolumeStreamsOption) settings() streamSettings {
+	return streamSettings{
+		backfillMinutes:  v.BackfillMinutes,
+		disableReconnect: v.DisableReconnect,
+		stallTimeout:     v.StallTimeout,
+		onStateChange:    v.OnStateChange,
+	}
 }
 
 func (v VolumeStreamsOption) addQuery(req *http.Request) {
@@ -456,6 +560,52 @@ func (r RetweetsLookupOption) addQuery(req *http.Request) {
 	}
 }
 
+type QuoteTweetsOption struct {
+	Exclude         []Exclude
+	Expansions      []Expansion
+	MaxResults      int
+	MediaFields     []MediaField
+	PaginationToken string
+	PlaceFields     []PlaceField
+	PollFields      []PollField
+	TweetFields     []TweetField
+	UserFields      []UserField
+}
+
+func (qt QuoteTweetsOption) addQuery(req *http.Request) {
+	q := req.URL.Query()
+	if len(qt.Exclude) > 0 {
+		q.Add("exclude", strings.Join(excludeToString(qt.Exclude), ","))
+	}
+	if len(qt.Expansions) > 0 {
+		q.Add("expansions", strings.Join(expansionsToString(qt.Expansions), ","))
+	}
+	if qt.MaxResults > 0 {
+		q.Add("max_results", strconv.Itoa(qt.MaxResults))
+	}
+	if len(qt.MediaFields) > 0 {
+		q.Add("media.fields", strings.Join(mediaFieldsToString(qt.MediaFields), ","))
+	}
+	if qt.PaginationToken != "" {
+		q.Add("pagination_token", qt.PaginationToken)
+	}
+	if len(qt.PlaceFields) > 0 {
+		q.Add("place.fields", strings.Join(placeFieldsToString(qt.PlaceFields), ","))
+	}
+	if len(qt.PollFields) > 0 {
+		q.Add("poll.fields", strings.Join(pollFieldsToString(qt.PollFields), ","))
+	}
+	if len(qt.TweetFields) > 0 {
+		q.Add("tweet.fields", strings.Join(tweetFieldsToString(qt.TweetFields), ","))
+	}
+	if len(qt.UserFields) > 0 {
+		q.Add("user.fields", strings.Join(userFieldsToString(qt.UserFields), ","))
+	}
+	if len(q) > 0 {
+		req.URL.RawQuery = q.Encode()
+	}
+}
+
 type TweetsUserLikedOption struct {
 	Expansions      []Expansion
 	MediaFields     []MediaField
@@ -563,3 +713,57 @@ func (l LookupUserBookmarksOption) addQuery(req *http.Request) {
 		req.URL.RawQuery = q.Encode()
 	}
 }
+
+type FetchConversationOption struct {
+	// FullArchive searches with SearchAllTweets instead of SearchRecentTweets, which only covers the last 7 days.
+	FullArchive bool
+	// MaxPages bounds the search pages of 100 replies. Zero fetches every page.
+	MaxPages    int
+	Expansions  []Expansion
+	MediaFields []MediaField
+	PlaceFields []PlaceField
+	PollFields  []PollField
+	// TweetFields always include the fields which link the replies together.
+	TweetFields []TweetField
+	UserFields  []UserField
+	// HiddenReplyIDs are the replies known to be hidden with HideReplies, which the API does not report.
+	HiddenReplyIDs []string
+}
+
+// idWindow returns the since_id and until_id which bound the Tweets posted from start and before end.
+// A zero time keeps the current bound.
+func idWindow(start, end time.Time, sinceID, untilID string) (string, string) {
+	if !start.IsZero() {
+		// since_id is exclusive, so the bound is the last ID before start.
+		if id := snowflake.FromTime(start); id > 0 {
+			sinceID = (id - 1).String()
+		} else {
+			sinceID = ""
+		}
+	}
+	if !end.IsZero() {
+		untilID = snowflake.FromTime(end).String()
+	}
+	return sinceID, untilID
+}
+
+// SetIDWindow sets SinceID and UntilID so that only Tweets posted from start and before end are returned.
+// A zero time leaves its bound unchanged.
+func (u *UserTweetTimelineOption) SetIDWindow(start, end time.Time) {
+	u.SinceID, u.UntilID = idWindow(start, end, u.SinceID, u.UntilID)
+}
+
+// SetIDWindow is like UserTweetTimelineOption.SetIDWindow.
+func (h *HomeTimelineOption) SetIDWindow(start, end time.Time) {
+	h.SinceID, h.UntilID = idWindow(start, end, h.SinceID, h.UntilID)
+}
+
+// SetIDWindow is like UserTweetTimelineOption.SetIDWindow.
+func (u *UserMentionTimelineOption) SetIDWindow(start, end time.Time) {
+	u.SinceID, u.UntilID = idWindow(start, end, u.SinceID, u.UntilID)
+}
+
+// SetIDWindow is like UserTweetTimelineOption.SetIDWindow.
+func (t *SearchTweetsOption) SetIDWindow(start, end time.Time) {
+	t.SinceID, t.UntilID = idWindow(start, end, t.SinceID, t.UntilID)
+}
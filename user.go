@@ -32,7 +32,7 @@ type User struct {
 	ID              string             `json:"id"`
 	Name            string             `json:"name"`
 	UserName        string             `json:"username"`
-	CreatedAt       string             `json:"created_at,omitempty"`
+	CreatedAt       Time               `json:"created_at,omitempty"`
 	Description     string             `json:"description,omitempty"`
 	Entities        *UserEntity        `json:"entities,omitempty"`
 	Location        string             `json:"location,omitempty"`
@@ -97,6 +97,7 @@ type UserPublicMetrics struct {
 type UserWithheld struct {
 	Copyright    bool     `json:"copyright"`
 	CountryCodes []string `json:"country_codes"`
+	Scope        string   `json:"scope,omitempty"`
 }
 
 type UserIncludes struct {
@@ -236,33 +237,7 @@ type MutingBody struct {
 }
 
 type MeResponse struct {
-	Me     *Me                 `json:"data"`
-	Errors []*APIResponseError `json:"errors,omitempty"`
-}
-
-type Me struct {
-	ID              string             `json:"id"`
-	Name            string             `json:"name"`
-	UserName        string             `json:"username"`
-	CreatedAt       string             `json:"created_at,omitempty"`
-	Protected       bool               `json:"protected,omitempty"`
-	Withheld        *MeWithheld        `json:"withheld,omitempty"`
-	Location        string             `json:"location,omitempty"`
-	URL             string             `json:"url,omitempty"`
-	Description     string             `json:"description,omitempty"`
-	Verified        bool               `json:"verified,omitempty"`
-	Entities        *UserEntity        `json:"entities,omitempty"`
-	ProfileImageURL string             `json:"profile_image_url,omitempty"`
-	PublicMetrics   *UserPublicMetrics `json:"public_metrics,omitempty"`
-	PinnedTweetID   string             `json:"pinned_tweet_id,omitempty"`
-	Includes        *MeIncludes        `json:"includes,omitempty"`
-}
-
-type MeWithheld struct {
-	CountryCodes []string `json:"country_codes"`
-	Scope        string   `json:"scope"`
-}
-
-type MeIncludes struct {
-	Tweets []*Tweet
+	Me       *User               `json:"data"`
+	Includes *UserIncludes       `json:"includes,omitempty"`
+	Errors   []*APIResponseError `json:"errors,omitempty"`
 }
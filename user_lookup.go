@@ -17,7 +17,7 @@ func retrieveMultipleUsersWithIDs(ctx context.Context, c *client, userIDs []stri
 		return nil, errors.New("retrieve multiple users with ids: ids parameter must be less than or equal to 100")
 	default:
 	}
-	ep := retrieveMultipleUsersWithIDsURL + strings.Join(userIDs, ",")
+	ep := c.baseURL + retrieveMultipleUsersWithIDsURL + strings.Join(userIDs, ",")
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ep, nil)
 	if err != nil {
@@ -36,7 +36,7 @@ func retrieveMultipleUsersWithIDs(ctx context.Context, c *client, userIDs []stri
 	}
 	ropt.addQuery(req)
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("retrieve multiple users with ids response: %w", err)
 	}
@@ -47,11 +47,7 @@ func retrieveMultipleUsersWithIDs(ctx context.Context, c *client, userIDs []stri
 		return nil, fmt.Errorf("retrieve multiple users with ids decode: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &ur, &HTTPError{
-			APIName: "user lookup",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &ur, newAPIError("user lookup", req, resp)
 	}
 
 	return &ur, nil
@@ -61,7 +57,7 @@ func retrieveSingleUserWithID(ctx context.Context, c *client, userID string, opt
 	if userID == "" {
 		return nil, errors.New("retrieve single user with id: user id is required")
 	}
-	ep := fmt.Sprintf(retrieveSingleUserWithIDURL, userID)
+	ep := c.baseURL + fmt.Sprintf(retrieveSingleUserWithIDURL, userID)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ep, nil)
 	if err != nil {
@@ -81,7 +77,7 @@ func retrieveSingleUserWithID(ctx context.Context, c *client, userID string, opt
 	}
 	ropt.addQuery(req)
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("retrieve single user with id response: %w", err)
 	}
@@ -93,11 +89,7 @@ func retrieveSingleUserWithID(ctx context.Context, c *client, userID string, opt
 		return nil, fmt.Errorf("retrieve single user with id decode: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &ur, &HTTPError{
-			APIName: "retrieve single user with id",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &ur, newAPIError("retrieve single user with id", req, resp)
 	}
 
 	return &ur, nil
@@ -111,7 +103,7 @@ func retrieveMultipleUsersWithUserNames(ctx context.Context, c *client, userName
 		return nil, errors.New("retrieve multiple users with user names: user names parameter must be less than or equal to 100")
 	default:
 	}
-	ep := retrieveMultipleUsersWithUserNamesURL + strings.Join(userNames, ",")
+	ep := c.baseURL + retrieveMultipleUsersWithUserNamesURL + strings.Join(userNames, ",")
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ep, nil)
 	if err != nil {
@@ -131,7 +123,7 @@ func retrieveMultipleUsersWithUserNames(ctx context.Context, c *client, userName
 	}
 	ropt.addQuery(req)
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("retrieve multiple users with user names response: %w", err)
 	}
@@ -143,11 +135,7 @@ func retrieveMultipleUsersWithUserNames(ctx context.Context, c *client, userName
 		return nil, fmt.Errorf("retrieve multiple users with user names decode: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &ur, &HTTPError{
-			APIName: "users lookup by usernames",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &ur, newAPIError("users lookup by usernames", req, resp)
 	}
 
 	return &ur, nil
@@ -157,7 +145,7 @@ func retrieveSingleUserWithUserName(ctx context.Context, c *client, userName str
 	if userName == "" {
 		return nil, errors.New("retrieve single user with user name: user name is required")
 	}
-	ep := fmt.Sprintf(retrieveSingleUserWithUserNameURL, userName)
+	ep := c.baseURL + fmt.Sprintf(retrieveSingleUserWithUserNameURL, userName)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ep, nil)
 	if err != nil {
@@ -177,7 +165,7 @@ func retrieveSingleUserWithUserName(ctx context.Context, c *client, userName str
 	}
 	ropt.addQuery(req)
 
-	resp, err := c.client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("retrieve single user with user name response: %w", err)
 	}
@@ -189,11 +177,7 @@ func retrieveSingleUserWithUserName(ctx context.Context, c *client, userName str
 		return nil, fmt.Errorf("retrieve single user with user name decode: %w", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return &ur, &HTTPError{
-			APIName: "retrieve single user with user name",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
+		return &ur, newAPIError("retrieve single user with user name", req, resp)
 	}
 
 	return &ur, nil
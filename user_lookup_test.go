@@ -113,7 +113,7 @@ func Test_retrieveMultipleUsersWithIDs(t *testing.T) {
 			want: &gotwtr.UsersResponse{
 				Users: []*gotwtr.User{
 					{
-						CreatedAt:     "2013-12-14T04:35:55.000Z",
+						CreatedAt:     mustParseTime("2013-12-14T04:35:55.000Z"),
 						UserName:      "TwitterDev",
 						PinnedTweetID: "1255542774432063488",
 						ID:            "2244994945",
@@ -123,12 +123,12 @@ func Test_retrieveMultipleUsersWithIDs(t *testing.T) {
 				Includes: &gotwtr.UserIncludes{
 					Tweets: []*gotwtr.Tweet{
 						{
-							CreatedAt: "2020-04-29T17:01:38.000Z",
+							CreatedAt: mustParseTime("2020-04-29T17:01:38.000Z"),
 							Text:      "During these unprecedented times, what’s happening on Twitter can help the world better understand &amp; respond to the pandemic. nnWe're launching a free COVID-19 stream endpoint so qualified devs &amp; researchers can study the public conversation in real-time. https://t.co/BPqMcQzhId",
 							ID:        "1255542774432063488",
 						},
 						{
-							CreatedAt: "2020-06-19T21:12:30.000Z",
+							CreatedAt: mustParseTime("2020-06-19T21:12:30.000Z"),
 							Text:      "📍 Minneapolisn🗣️ @FredTJoseph https://t.co/lNTOkyguG1",
 							ID:        "1274087687469715457",
 						},
@@ -242,14 +242,14 @@ func Test_retrieveMultipleUsersWithIDs(t *testing.T) {
 			want: &gotwtr.UsersResponse{
 				Users: []*gotwtr.User{
 					{
-						CreatedAt:     "2013-12-14T04:35:55.000Z",
+						CreatedAt:     mustParseTime("2013-12-14T04:35:55.000Z"),
 						UserName:      "TwitterDev",
 						PinnedTweetID: "1255542774432063488",
 						ID:            "2244994945",
 						Name:          "Twitter Dev",
 					},
 					{
-						CreatedAt:     "2007-02-20T14:35:54.000Z",
+						CreatedAt:     mustParseTime("2007-02-20T14:35:54.000Z"),
 						UserName:      "Twitter",
 						PinnedTweetID: "1274087687469715457",
 						ID:            "783214",
@@ -259,12 +259,12 @@ func Test_retrieveMultipleUsersWithIDs(t *testing.T) {
 				Includes: &gotwtr.UserIncludes{
 					Tweets: []*gotwtr.Tweet{
 						{
-							CreatedAt: "2020-04-29T17:01:38.000Z",
+							CreatedAt: mustParseTime("2020-04-29T17:01:38.000Z"),
 							Text:      "During these unprecedented times, what’s happening on Twitter can help the world better understand &amp; respond to the pandemic. nnWe're launching a free COVID-19 stream endpoint so qualified devs &amp; researchers can study the public conversation in real-time. https://t.co/BPqMcQzhId",
 							ID:        "1255542774432063488",
 						},
 						{
-							CreatedAt: "2020-06-19T21:12:30.000Z",
+							CreatedAt: mustParseTime("2020-06-19T21:12:30.000Z"),
 							Text:      "📍 Minneapolisn🗣️ @FredTJoseph https://t.co/lNTOkyguG1",
 							ID:        "1274087687469715457",
 						},
@@ -495,7 +495,7 @@ func Test_retrieveSingleUserWithID(t *testing.T) {
 			want: &gotwtr.UserResponse{
 				User: &gotwtr.User{
 					UserName:      "TwitterDev",
-					CreatedAt:     "2013-12-14T04:35:55.000Z",
+					CreatedAt:     mustParseTime("2013-12-14T04:35:55.000Z"),
 					PinnedTweetID: "1255542774432063488",
 					ID:            "2244994945",
 					Name:          "Twitter Dev",
@@ -504,7 +504,7 @@ func Test_retrieveSingleUserWithID(t *testing.T) {
 					Tweets: []*gotwtr.Tweet{
 						{
 							Text:      "During these unprecedented times, what’s happening on Twitter can help the world better understand &amp; respond to the pandemic. nnWe're launching a free COVID-19 stream endpoint so qualified devs &amp; researchers can study the public conversation in real-time. https://t.co/BPqMcQzhId",
-							CreatedAt: "2020-04-29T17:01:38.000Z",
+							CreatedAt: mustParseTime("2020-04-29T17:01:38.000Z"),
 							ID:        "1255542774432063488",
 						},
 					},
@@ -661,7 +661,7 @@ func Test_retrieveSingleUserWithUserName(t *testing.T) {
 			want: &gotwtr.UserResponse{
 				User: &gotwtr.User{
 					UserName:      "TwitterDev",
-					CreatedAt:     "2013-12-14T04:35:55.000Z",
+					CreatedAt:     mustParseTime("2013-12-14T04:35:55.000Z"),
 					PinnedTweetID: "1255542774432063488",
 					ID:            "2244994945",
 					Name:          "Twitter Dev",
@@ -670,7 +670,7 @@ func Test_retrieveSingleUserWithUserName(t *testing.T) {
 					Tweets: []*gotwtr.Tweet{
 						{
 							Text:      "During these unprecedented times, what’s happening on Twitter can help the world better understand &amp; respond to the pandemic. nnWe're launching a free COVID-19 stream endpoint so qualified devs &amp; researchers can study the public conversation in real-time. https://t.co/BPqMcQzhId",
-							CreatedAt: "2020-04-29T17:01:38.000Z",
+							CreatedAt: mustParseTime("2020-04-29T17:01:38.000Z"),
 							ID:        "1255542774432063488",
 						},
 					},
@@ -856,14 +856,14 @@ func Test_retrieveMultipleUsersWithUserNames(t *testing.T) {
 			want: &gotwtr.UsersResponse{
 				Users: []*gotwtr.User{
 					{
-						CreatedAt:     "2013-12-14T04:35:55.000Z",
+						CreatedAt:     mustParseTime("2013-12-14T04:35:55.000Z"),
 						UserName:      "TwitterDev",
 						PinnedTweetID: "1255542774432063488",
 						ID:            "2244994945",
 						Name:          "Twitter Dev",
 					},
 					{
-						CreatedAt:     "2007-02-20T14:35:54.000Z",
+						CreatedAt:     mustParseTime("2007-02-20T14:35:54.000Z"),
 						UserName:      "Twitter",
 						PinnedTweetID: "1274087687469715457",
 						ID:            "783214",
@@ -873,12 +873,12 @@ func Test_retrieveMultipleUsersWithUserNames(t *testing.T) {
 				Includes: &gotwtr.UserIncludes{
 					Tweets: []*gotwtr.Tweet{
 						{
-							CreatedAt: "2020-04-29T17:01:38.000Z",
+							CreatedAt: mustParseTime("2020-04-29T17:01:38.000Z"),
 							Text:      "During these unprecedented times, what’s happening on Twitter can help the world better understand &amp; respond to the pandemic. nnWe're launching a free COVID-19 stream endpoint so qualified devs &amp; researchers can study the public conversation in real-time. https://t.co/BPqMcQzhId",
 							ID:        "1255542774432063488",
 						},
 						{
-							CreatedAt: "2020-06-19T21:12:30.000Z",
+							CreatedAt: mustParseTime("2020-06-19T21:12:30.000Z"),
 							Text:      "📍 Minneapolisn🗣️ @FredTJoseph https://t.co/lNTOkyguG1",
 							ID:        "1274087687469715457",
 						},
@@ -2,84 +2,57 @@ package gotwtr
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
 	"net/http"
-	"sync"
+	"strconv"
 )
 
-func stopped(done <-chan struct{}) bool {
-	select {
-	case <-done:
-		return true
+func volumeStreams(ctx context.Context, c *client, ch chan<- VolumeStreamsResponse, errCh chan<- error, opt ...*VolumeStreamsOption) *VolumeStreams {
+	var (
+		vopt VolumeStreamsOption
+		err  error
+	)
+	switch len(opt) {
+	case 0:
+		// do nothing
+	case 1:
+		vopt = *opt[0]
 	default:
-		return false
-	}
-}
-
-func (s *VolumeStreams) Stop() {
-	close(s.done)
-	s.wg.Wait()
-}
-
-func (s *VolumeStreams) retry(req *http.Request) {
-	defer s.wg.Done()
-	resp, err := s.client.Do(req)
-	if err != nil {
-		s.errCh <- err
-		return
-	}
-	defer resp.Body.Close()
-	if resp.StatusCode != http.StatusOK {
-		s.errCh <- &HTTPError{
-			APIName: "sampled stream",
-			Status:  resp.Status,
-			URL:     req.URL.String(),
-		}
-		return
+		err = errors.New("sampled stream: only one option is allowed")
 	}
-	dec := json.NewDecoder(resp.Body)
-	for !stopped(s.done) {
-		var v VolumeStreamsResponse
-		err := dec.Decode(&v)
-		if err != nil {
-			if err == io.EOF {
-				break
-			}
-			s.errCh <- err
-		}
-		s.ch <- v
+	return &VolumeStreams{
+		stream: newStream(ctx, c, "sampled stream", volumeStreamsURL, ch, errCh, vopt.settings(), vopt.addQuery, err),
 	}
 }
 
-func volumeStreams(ctx context.Context, c *client, ch chan<- VolumeStreamsResponse, errCh chan<- error, opt ...*VolumeStreamsOption) *VolumeStreams {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, volumeStreamsURL, nil)
-	if err != nil {
-		errCh <- fmt.Errorf("sampled stream new request with ctx: %w", err)
-	}
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.bearerToken))
-
-	var vopt VolumeStreamsOption
+func volumeStreams10(ctx context.Context, c *client, partition int, ch chan<- VolumeStreamsResponse, errCh chan<- error, opt ...*VolumeStreamsOption) *VolumeStreams {
+	var (
+		vopt VolumeStreamsOption
+		err  error
+	)
 	switch len(opt) {
 	case 0:
 		// do nothing
 	case 1:
 		vopt = *opt[0]
 	default:
-		errCh <- errors.New("sampled stream: only one option is allowed")
+		err = errors.New("sampled 10 stream: only one option is allowed")
 	}
-	vopt.addQuery(req)
-
-	vs := &VolumeStreams{
-		client: c.client,
-		errCh:  errCh,
-		ch:     ch,
-		done:   make(chan struct{}),
-		wg:     &sync.WaitGroup{},
+	const (
+		minimumPartition = 1
+		maximumPartition = 2
+	)
+	if partition < minimumPartition || partition > maximumPartition {
+		err = fmt.Errorf("sampled 10 stream: partition must be between %d and %d", minimumPartition, maximumPartition)
+	}
+	addQuery := func(req *http.Request) {
+		vopt.addQuery(req)
+		q := req.URL.Query()
+		q.Set("partition", strconv.Itoa(partition))
+		req.URL.RawQuery = q.Encode()
+	}
+	return &VolumeStreams{
+		stream: newStream(ctx, c, "sampled 10 stream", volumeStreams10URL, ch, errCh, vopt.settings(), addQuery, err),
 	}
-	vs.wg.Add(1)
-	go vs.retry(req)
-	return vs
 }
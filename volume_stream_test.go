@@ -5,7 +5,9 @@ import (
 	"io"
 	"net/http"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 
@@ -75,3 +77,57 @@ func Test_sampledStream(t *testing.T) {
 		})
 	}
 }
+
+func Test_VolumeStreams10(t *testing.T) {
+	t.Parallel()
+	var (
+		mu       sync.Mutex
+		requests []string
+	)
+	c := gotwtr.New("key", gotwtr.WithHTTPClient(mockHTTPClient(func(req *http.Request) *http.Response {
+		mu.Lock()
+		requests = append(requests, req.URL.Path+"?"+req.URL.RawQuery)
+		mu.Unlock()
+		body := `{"data": {"id": "1067094924124872705", "text": "Just getting started with Twitter APIs?"}}`
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+		}
+	})))
+	ch := make(chan gotwtr.VolumeStreamsResponse)
+	errCh := make(chan error, 1)
+	stream := c.VolumeStreams10(context.Background(), 2, ch, errCh, &gotwtr.VolumeStreamsOption{TweetFields: []gotwtr.TweetField{gotwtr.TweetFieldAuthorID}})
+	// the body ends after one Tweet, so the second one comes from a new connection.
+	for i := 0; i < 2; i++ {
+		select {
+		case got := <-ch:
+			if got.Tweet == nil || got.Tweet.ID != "1067094924124872705" {
+				t.Errorf("client.VolumeStreams10() = %+v", got)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("client.VolumeStreams10() did not reconnect")
+		}
+	}
+	stream.Stop()
+	stream.Stop()
+	mu.Lock()
+	defer mu.Unlock()
+	if want := "/2/tweets/sample10/stream?partition=2&tweet.fields=author_id"; requests[0] != want {
+		t.Errorf("request = %s, want %s", requests[0], want)
+	}
+}
+
+func Test_VolumeStreams10InvalidPartition(t *testing.T) {
+	t.Parallel()
+	c := gotwtr.New("key", gotwtr.WithHTTPClient(mockHTTPClient(func(req *http.Request) *http.Response {
+		t.Error("no request must be sent")
+		return nil
+	})))
+	ch := make(chan gotwtr.VolumeStreamsResponse)
+	errCh := make(chan error)
+	stream := c.VolumeStreams10(context.Background(), 3, ch, errCh)
+	if err := <-errCh; err == nil {
+		t.Error("client.VolumeStreams10() error = nil, want an error")
+	}
+	stream.Stop()
+}